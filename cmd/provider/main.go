@@ -7,11 +7,23 @@ import (
 	"github.com/alchematik/athanor-provider-gcp/internal/api_gateway"
 	"github.com/alchematik/athanor-provider-gcp/internal/bucket"
 	"github.com/alchematik/athanor-provider-gcp/internal/bucket_object"
+	"github.com/alchematik/athanor-provider-gcp/internal/cloud_run_job"
+	"github.com/alchematik/athanor-provider-gcp/internal/cloud_run_service"
 	"github.com/alchematik/athanor-provider-gcp/internal/function"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam_binding"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam_member"
 	"github.com/alchematik/athanor-provider-gcp/internal/iam_policy"
 	"github.com/alchematik/athanor-provider-gcp/internal/iam_role"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam_role_custom_organization"
 	"github.com/alchematik/athanor-provider-gcp/internal/iam_role_custom_project"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam_role_query"
+	"github.com/alchematik/athanor-provider-gcp/internal/project"
+	"github.com/alchematik/athanor-provider-gcp/internal/pubsub_subscription"
+	"github.com/alchematik/athanor-provider-gcp/internal/pubsub_topic"
+	"github.com/alchematik/athanor-provider-gcp/internal/secret"
+	"github.com/alchematik/athanor-provider-gcp/internal/secret_version"
 	"github.com/alchematik/athanor-provider-gcp/internal/service_account"
+	"github.com/alchematik/athanor-provider-gcp/internal/service_account_key"
 
 	"github.com/alchematik/athanor-go/sdk/provider/plugin"
 )
@@ -33,20 +45,56 @@ func main() {
 		"bucket_object": func(ctx context.Context) (plugin.ResourceHandler, error) {
 			return bucket_object.NewHandler(ctx)
 		},
+		"cloud_run_job": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return cloud_run_job.NewHandler(ctx)
+		},
+		"cloud_run_service": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return cloud_run_service.NewHandler(ctx)
+		},
 		"function": func(ctx context.Context) (plugin.ResourceHandler, error) {
 			return function.NewHandler(ctx)
 		},
 		"service_account": func(ctx context.Context) (plugin.ResourceHandler, error) {
 			return service_account.NewHandler(ctx)
 		},
+		"service_account_key": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return service_account_key.NewHandler(ctx)
+		},
 		"iam_role": func(ctx context.Context) (plugin.ResourceHandler, error) {
 			return iam_role.NewHandler(ctx)
 		},
+		"iam_role_custom_organization": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return iam_role_custom_organization.NewHandler(ctx)
+		},
 		"iam_role_custom_project": func(ctx context.Context) (plugin.ResourceHandler, error) {
 			return iam_role_custom_project.NewHandler(ctx)
 		},
+		"iam_role_query": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return iam_role_query.NewHandler(ctx)
+		},
 		"iam_policy": func(ctx context.Context) (plugin.ResourceHandler, error) {
 			return iam_policy.NewHandler(ctx)
 		},
+		"iam_binding": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return iam_binding.NewHandler(ctx)
+		},
+		"iam_member": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return iam_member.NewHandler(ctx)
+		},
+		"project": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return project.NewHandler(ctx)
+		},
+		"pubsub_topic": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return pubsub_topic.NewHandler(ctx)
+		},
+		"pubsub_subscription": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return pubsub_subscription.NewHandler(ctx)
+		},
+		"secret": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return secret.NewHandler(ctx)
+		},
+		"secret_version": func(ctx context.Context) (plugin.ResourceHandler, error) {
+			return secret_version.NewHandler(ctx)
+		},
 	})
 }
@@ -193,12 +193,38 @@ func ParseAttrsList(v any) ([]Attrs, error) {
 }
 
 type Config struct {
-	Labels map[string]string
+	Autoclass                bool
+	Cors                     []Cors
+	DefaultKmsKey            []sdk.ResourceIdentifier
+	ForceDestroy             bool
+	Labels                   map[string]string
+	LifecycleRules           []LifecycleRule
+	PublicAccessPrevention   string
+	RequesterPays            bool
+	RetentionPolicy          RetentionPolicy
+	SoftDeleteRetention      string
+	StorageClass             string
+	UniformBucketLevelAccess bool
+	Versioning               bool
+	Website                  Website
 }
 
 func (x Config) ToValue() any {
 	return map[string]any{
-		"labels": sdk.ToType[string](x.Labels),
+		"autoclass":                   sdk.ToType[any](x.Autoclass),
+		"cors":                        sdk.ToType[Cors](x.Cors),
+		"default_kms_key":             sdk.ToType[sdk.ResourceIdentifier](x.DefaultKmsKey),
+		"force_destroy":               sdk.ToType[any](x.ForceDestroy),
+		"labels":                      sdk.ToType[string](x.Labels),
+		"lifecycle_rules":             sdk.ToType[LifecycleRule](x.LifecycleRules),
+		"public_access_prevention":    sdk.ToType[any](x.PublicAccessPrevention),
+		"requester_pays":              sdk.ToType[any](x.RequesterPays),
+		"retention_policy":            sdk.ToType[any](x.RetentionPolicy),
+		"soft_delete_retention":       sdk.ToType[any](x.SoftDeleteRetention),
+		"storage_class":               sdk.ToType[any](x.StorageClass),
+		"uniform_bucket_level_access": sdk.ToType[any](x.UniformBucketLevelAccess),
+		"versioning":                  sdk.ToType[any](x.Versioning),
+		"website":                     sdk.ToType[any](x.Website),
 	}
 }
 
@@ -208,13 +234,78 @@ func ParseConfig(v any) (Config, error) {
 		return Config{}, fmt.Errorf("error parsing config: %v", err)
 	}
 
+	autoclass, err := sdk.Bool(m["autoclass"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	cors, err := ParseCorsList(m["cors"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	default_kms_key, err := identifier.ParseIdentifierList(m["default_kms_key"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	force_destroy, err := sdk.Bool(m["force_destroy"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
 	labels, err := sdk.Map[string](m["labels"])
 	if err != nil {
 		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
 	}
+	lifecycle_rules, err := ParseLifecycleRuleList(m["lifecycle_rules"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	public_access_prevention, err := sdk.String(m["public_access_prevention"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	requester_pays, err := sdk.Bool(m["requester_pays"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	retention_policy, err := ParseRetentionPolicy(m["retention_policy"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	soft_delete_retention, err := sdk.String(m["soft_delete_retention"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	storage_class, err := sdk.String(m["storage_class"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	uniform_bucket_level_access, err := sdk.Bool(m["uniform_bucket_level_access"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	versioning, err := sdk.Bool(m["versioning"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
+	website, err := ParseWebsite(m["website"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for bucket: %v", err)
+	}
 
 	return Config{
-		Labels: labels,
+		Autoclass:                autoclass,
+		Cors:                     cors,
+		DefaultKmsKey:            default_kms_key,
+		ForceDestroy:             force_destroy,
+		Labels:                   labels,
+		LifecycleRules:           lifecycle_rules,
+		PublicAccessPrevention:   public_access_prevention,
+		RequesterPays:            requester_pays,
+		RetentionPolicy:          retention_policy,
+		SoftDeleteRetention:      soft_delete_retention,
+		StorageClass:             storage_class,
+		UniformBucketLevelAccess: uniform_bucket_level_access,
+		Versioning:               versioning,
+		Website:                  website,
 	}, nil
 }
 
@@ -236,3 +327,305 @@ func ParseConfigList(v any) ([]Config, error) {
 
 	return vals, nil
 }
+
+type Cors struct {
+	MaxAge          string
+	Methods         []string
+	Origins         []string
+	ResponseHeaders []string
+}
+
+func (x Cors) ToValue() any {
+	return map[string]any{
+		"max_age":          sdk.ToType[any](x.MaxAge),
+		"methods":          sdk.ToType[string](x.Methods),
+		"origins":          sdk.ToType[string](x.Origins),
+		"response_headers": sdk.ToType[string](x.ResponseHeaders),
+	}
+}
+
+func ParseCors(v any) (Cors, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Cors{}, fmt.Errorf("error parsing cors: %v", err)
+	}
+
+	max_age, err := sdk.String(m["max_age"])
+	if err != nil {
+		return Cors{}, fmt.Errorf("error parsing cors for bucket: %v", err)
+	}
+	methods, err := sdk.List[string](m["methods"])
+	if err != nil {
+		return Cors{}, fmt.Errorf("error parsing cors for bucket: %v", err)
+	}
+	origins, err := sdk.List[string](m["origins"])
+	if err != nil {
+		return Cors{}, fmt.Errorf("error parsing cors for bucket: %v", err)
+	}
+	response_headers, err := sdk.List[string](m["response_headers"])
+	if err != nil {
+		return Cors{}, fmt.Errorf("error parsing cors for bucket: %v", err)
+	}
+
+	return Cors{
+		MaxAge:          max_age,
+		Methods:         methods,
+		Origins:         origins,
+		ResponseHeaders: response_headers,
+	}, nil
+}
+
+func ParseCorsList(v any) ([]Cors, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Cors
+	for _, val := range list {
+		p, err := ParseCors(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type LifecycleCondition struct {
+	Age              string
+	CreatedBefore    string
+	MatchesPrefix    []string
+	MatchesSuffix    []string
+	NumNewerVersions string
+	WithState        string
+}
+
+func (x LifecycleCondition) ToValue() any {
+	return map[string]any{
+		"age":                sdk.ToType[any](x.Age),
+		"created_before":     sdk.ToType[any](x.CreatedBefore),
+		"matches_prefix":     sdk.ToType[string](x.MatchesPrefix),
+		"matches_suffix":     sdk.ToType[string](x.MatchesSuffix),
+		"num_newer_versions": sdk.ToType[any](x.NumNewerVersions),
+		"with_state":         sdk.ToType[any](x.WithState),
+	}
+}
+
+func ParseLifecycleCondition(v any) (LifecycleCondition, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition: %v", err)
+	}
+
+	age, err := sdk.String(m["age"])
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition for bucket: %v", err)
+	}
+	created_before, err := sdk.String(m["created_before"])
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition for bucket: %v", err)
+	}
+	matches_prefix, err := sdk.List[string](m["matches_prefix"])
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition for bucket: %v", err)
+	}
+	matches_suffix, err := sdk.List[string](m["matches_suffix"])
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition for bucket: %v", err)
+	}
+	num_newer_versions, err := sdk.String(m["num_newer_versions"])
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition for bucket: %v", err)
+	}
+	with_state, err := sdk.String(m["with_state"])
+	if err != nil {
+		return LifecycleCondition{}, fmt.Errorf("error parsing lifecycle_condition for bucket: %v", err)
+	}
+
+	return LifecycleCondition{
+		Age:              age,
+		CreatedBefore:    created_before,
+		MatchesPrefix:    matches_prefix,
+		MatchesSuffix:    matches_suffix,
+		NumNewerVersions: num_newer_versions,
+		WithState:        with_state,
+	}, nil
+}
+
+func ParseLifecycleConditionList(v any) ([]LifecycleCondition, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []LifecycleCondition
+	for _, val := range list {
+		p, err := ParseLifecycleCondition(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type LifecycleRule struct {
+	Action       string
+	Condition    LifecycleCondition
+	StorageClass string
+}
+
+func (x LifecycleRule) ToValue() any {
+	return map[string]any{
+		"action":        sdk.ToType[any](x.Action),
+		"condition":     sdk.ToType[any](x.Condition),
+		"storage_class": sdk.ToType[any](x.StorageClass),
+	}
+}
+
+func ParseLifecycleRule(v any) (LifecycleRule, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return LifecycleRule{}, fmt.Errorf("error parsing lifecycle_rule: %v", err)
+	}
+
+	action, err := sdk.String(m["action"])
+	if err != nil {
+		return LifecycleRule{}, fmt.Errorf("error parsing lifecycle_rule for bucket: %v", err)
+	}
+	condition, err := ParseLifecycleCondition(m["condition"])
+	if err != nil {
+		return LifecycleRule{}, fmt.Errorf("error parsing lifecycle_rule for bucket: %v", err)
+	}
+	storage_class, err := sdk.String(m["storage_class"])
+	if err != nil {
+		return LifecycleRule{}, fmt.Errorf("error parsing lifecycle_rule for bucket: %v", err)
+	}
+
+	return LifecycleRule{
+		Action:       action,
+		Condition:    condition,
+		StorageClass: storage_class,
+	}, nil
+}
+
+func ParseLifecycleRuleList(v any) ([]LifecycleRule, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []LifecycleRule
+	for _, val := range list {
+		p, err := ParseLifecycleRule(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type RetentionPolicy struct {
+	RetentionPeriod string
+}
+
+func (x RetentionPolicy) ToValue() any {
+	return map[string]any{
+		"retention_period": sdk.ToType[any](x.RetentionPeriod),
+	}
+}
+
+func ParseRetentionPolicy(v any) (RetentionPolicy, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return RetentionPolicy{}, fmt.Errorf("error parsing retention_policy: %v", err)
+	}
+
+	retention_period, err := sdk.String(m["retention_period"])
+	if err != nil {
+		return RetentionPolicy{}, fmt.Errorf("error parsing retention_policy for bucket: %v", err)
+	}
+
+	return RetentionPolicy{
+		RetentionPeriod: retention_period,
+	}, nil
+}
+
+func ParseRetentionPolicyList(v any) ([]RetentionPolicy, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []RetentionPolicy
+	for _, val := range list {
+		p, err := ParseRetentionPolicy(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Website struct {
+	MainPageSuffix string
+	NotFoundPage   string
+}
+
+func (x Website) ToValue() any {
+	return map[string]any{
+		"main_page_suffix": sdk.ToType[any](x.MainPageSuffix),
+		"not_found_page":   sdk.ToType[any](x.NotFoundPage),
+	}
+}
+
+func ParseWebsite(v any) (Website, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Website{}, fmt.Errorf("error parsing website: %v", err)
+	}
+
+	main_page_suffix, err := sdk.String(m["main_page_suffix"])
+	if err != nil {
+		return Website{}, fmt.Errorf("error parsing website for bucket: %v", err)
+	}
+	not_found_page, err := sdk.String(m["not_found_page"])
+	if err != nil {
+		return Website{}, fmt.Errorf("error parsing website for bucket: %v", err)
+	}
+
+	return Website{
+		MainPageSuffix: main_page_suffix,
+		NotFoundPage:   not_found_page,
+	}, nil
+}
+
+func ParseWebsiteList(v any) ([]Website, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Website
+	for _, val := range list {
+		p, err := ParseWebsite(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
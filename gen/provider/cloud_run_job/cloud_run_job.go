@@ -0,0 +1,353 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package cloud_run_job
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type CloudRunJob struct {
+	Identifier identifier.CloudRunJobIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x CloudRunJob) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type CloudRunJobGetter interface {
+	GetCloudRunJob(context.Context, identifier.CloudRunJobIdentifier) (CloudRunJob, error)
+}
+
+type CloudRunJobCreator interface {
+	CreateCloudRunJob(context.Context, identifier.CloudRunJobIdentifier, Config) (CloudRunJob, error)
+}
+
+type CloudRunJobUpdator interface {
+	UpdateCloudRunJob(context.Context, identifier.CloudRunJobIdentifier, Config, []sdk.UpdateMaskField) (CloudRunJob, error)
+}
+
+type CloudRunJobDeleter interface {
+	DeleteCloudRunJob(context.Context, identifier.CloudRunJobIdentifier) error
+}
+
+type CloudRunJobHandler struct {
+	CloudRunJobGetter  CloudRunJobGetter
+	CloudRunJobCreator CloudRunJobCreator
+	CloudRunJobUpdator CloudRunJobUpdator
+	CloudRunJobDeleter CloudRunJobDeleter
+
+	CloseFunc func() error
+}
+
+func (h *CloudRunJobHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.CloudRunJobGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunJobIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.CloudRunJobGetter.GetCloudRunJob(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *CloudRunJobHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.CloudRunJobCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunJobIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.CloudRunJobCreator.CreateCloudRunJob(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *CloudRunJobHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.CloudRunJobUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunJobIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.CloudRunJobUpdator.UpdateCloudRunJob(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *CloudRunJobHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.CloudRunJobDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunJobIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.CloudRunJobDeleter.DeleteCloudRunJob(ctx, idVal)
+}
+
+func (h *CloudRunJobHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	LatestCreatedExecution string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"latest_created_execution": sdk.ToType[any](x.LatestCreatedExecution),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	latest_created_execution, err := sdk.String(m["latest_created_execution"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for cloud_run_job: %v", err)
+	}
+
+	return Attrs{
+		LatestCreatedExecution: latest_created_execution,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	Container      Container
+	Labels         map[string]string
+	MaxRetries     string
+	Parallelism    string
+	ServiceAccount sdk.ResourceIdentifier
+	TaskCount      string
+	Timeout        string
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"container":       sdk.ToType[any](x.Container),
+		"labels":          sdk.ToType[string](x.Labels),
+		"max_retries":     sdk.ToType[any](x.MaxRetries),
+		"parallelism":     sdk.ToType[any](x.Parallelism),
+		"service_account": sdk.ToType[any](x.ServiceAccount),
+		"task_count":      sdk.ToType[any](x.TaskCount),
+		"timeout":         sdk.ToType[any](x.Timeout),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	container, err := ParseContainer(m["container"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+	labels, err := sdk.Map[string](m["labels"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+	max_retries, err := sdk.String(m["max_retries"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+	parallelism, err := sdk.String(m["parallelism"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+	service_account, err := identifier.ParseIdentifier(m["service_account"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+	task_count, err := sdk.String(m["task_count"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+	timeout, err := sdk.String(m["timeout"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_job: %v", err)
+	}
+
+	return Config{
+		Container:      container,
+		Labels:         labels,
+		MaxRetries:     max_retries,
+		Parallelism:    parallelism,
+		ServiceAccount: service_account,
+		TaskCount:      task_count,
+		Timeout:        timeout,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Container struct {
+	Args    []string
+	Command []string
+	Cpu     string
+	Env     map[string]string
+	Image   string
+	Memory  string
+}
+
+func (x Container) ToValue() any {
+	return map[string]any{
+		"args":    sdk.ToType[string](x.Args),
+		"command": sdk.ToType[string](x.Command),
+		"cpu":     sdk.ToType[any](x.Cpu),
+		"env":     sdk.ToType[string](x.Env),
+		"image":   sdk.ToType[any](x.Image),
+		"memory":  sdk.ToType[any](x.Memory),
+	}
+}
+
+func ParseContainer(v any) (Container, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container: %v", err)
+	}
+
+	args, err := sdk.List[string](m["args"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_job: %v", err)
+	}
+	command, err := sdk.List[string](m["command"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_job: %v", err)
+	}
+	cpu, err := sdk.String(m["cpu"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_job: %v", err)
+	}
+	env, err := sdk.Map[string](m["env"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_job: %v", err)
+	}
+	image, err := sdk.String(m["image"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_job: %v", err)
+	}
+	memory, err := sdk.String(m["memory"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_job: %v", err)
+	}
+
+	return Container{
+		Args:    args,
+		Command: command,
+		Cpu:     cpu,
+		Env:     env,
+		Image:   image,
+		Memory:  memory,
+	}, nil
+}
+
+func ParseContainerList(v any) ([]Container, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Container
+	for _, val := range list {
+		p, err := ParseContainer(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,398 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package cloud_run_service
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type CloudRunService struct {
+	Identifier identifier.CloudRunServiceIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x CloudRunService) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type CloudRunServiceGetter interface {
+	GetCloudRunService(context.Context, identifier.CloudRunServiceIdentifier) (CloudRunService, error)
+}
+
+type CloudRunServiceCreator interface {
+	CreateCloudRunService(context.Context, identifier.CloudRunServiceIdentifier, Config) (CloudRunService, error)
+}
+
+type CloudRunServiceUpdator interface {
+	UpdateCloudRunService(context.Context, identifier.CloudRunServiceIdentifier, Config, []sdk.UpdateMaskField) (CloudRunService, error)
+}
+
+type CloudRunServiceDeleter interface {
+	DeleteCloudRunService(context.Context, identifier.CloudRunServiceIdentifier) error
+}
+
+type CloudRunServiceHandler struct {
+	CloudRunServiceGetter  CloudRunServiceGetter
+	CloudRunServiceCreator CloudRunServiceCreator
+	CloudRunServiceUpdator CloudRunServiceUpdator
+	CloudRunServiceDeleter CloudRunServiceDeleter
+
+	CloseFunc func() error
+}
+
+func (h *CloudRunServiceHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.CloudRunServiceGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunServiceIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.CloudRunServiceGetter.GetCloudRunService(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *CloudRunServiceHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.CloudRunServiceCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunServiceIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.CloudRunServiceCreator.CreateCloudRunService(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *CloudRunServiceHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.CloudRunServiceUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunServiceIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.CloudRunServiceUpdator.UpdateCloudRunService(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *CloudRunServiceHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.CloudRunServiceDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseCloudRunServiceIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.CloudRunServiceDeleter.DeleteCloudRunService(ctx, idVal)
+}
+
+func (h *CloudRunServiceHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	LatestReadyRevision string
+	Url                 string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"latest_ready_revision": sdk.ToType[any](x.LatestReadyRevision),
+		"url":                   sdk.ToType[any](x.Url),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	latest_ready_revision, err := sdk.String(m["latest_ready_revision"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for cloud_run_service: %v", err)
+	}
+	url, err := sdk.String(m["url"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for cloud_run_service: %v", err)
+	}
+
+	return Attrs{
+		LatestReadyRevision: latest_ready_revision,
+		Url:                 url,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	Container                     Container
+	Description                   string
+	Ingress                       string
+	Labels                        map[string]string
+	MaxInstanceRequestConcurrency string
+	Scaling                       Scaling
+	ServiceAccount                sdk.ResourceIdentifier
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"container":                        sdk.ToType[any](x.Container),
+		"description":                      sdk.ToType[any](x.Description),
+		"ingress":                          sdk.ToType[any](x.Ingress),
+		"labels":                           sdk.ToType[string](x.Labels),
+		"max_instance_request_concurrency": sdk.ToType[any](x.MaxInstanceRequestConcurrency),
+		"scaling":                          sdk.ToType[any](x.Scaling),
+		"service_account":                  sdk.ToType[any](x.ServiceAccount),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	container, err := ParseContainer(m["container"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+	description, err := sdk.String(m["description"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+	ingress, err := sdk.String(m["ingress"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+	labels, err := sdk.Map[string](m["labels"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+	max_instance_request_concurrency, err := sdk.String(m["max_instance_request_concurrency"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+	scaling, err := ParseScaling(m["scaling"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+	service_account, err := identifier.ParseIdentifier(m["service_account"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for cloud_run_service: %v", err)
+	}
+
+	return Config{
+		Container:                     container,
+		Description:                   description,
+		Ingress:                       ingress,
+		Labels:                        labels,
+		MaxInstanceRequestConcurrency: max_instance_request_concurrency,
+		Scaling:                       scaling,
+		ServiceAccount:                service_account,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Container struct {
+	Cpu    string
+	Env    map[string]string
+	Image  string
+	Memory string
+}
+
+func (x Container) ToValue() any {
+	return map[string]any{
+		"cpu":    sdk.ToType[any](x.Cpu),
+		"env":    sdk.ToType[string](x.Env),
+		"image":  sdk.ToType[any](x.Image),
+		"memory": sdk.ToType[any](x.Memory),
+	}
+}
+
+func ParseContainer(v any) (Container, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container: %v", err)
+	}
+
+	cpu, err := sdk.String(m["cpu"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_service: %v", err)
+	}
+	env, err := sdk.Map[string](m["env"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_service: %v", err)
+	}
+	image, err := sdk.String(m["image"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_service: %v", err)
+	}
+	memory, err := sdk.String(m["memory"])
+	if err != nil {
+		return Container{}, fmt.Errorf("error parsing container for cloud_run_service: %v", err)
+	}
+
+	return Container{
+		Cpu:    cpu,
+		Env:    env,
+		Image:  image,
+		Memory: memory,
+	}, nil
+}
+
+func ParseContainerList(v any) ([]Container, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Container
+	for _, val := range list {
+		p, err := ParseContainer(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Scaling struct {
+	MaxInstanceCount string
+	MinInstanceCount string
+}
+
+func (x Scaling) ToValue() any {
+	return map[string]any{
+		"max_instance_count": sdk.ToType[any](x.MaxInstanceCount),
+		"min_instance_count": sdk.ToType[any](x.MinInstanceCount),
+	}
+}
+
+func ParseScaling(v any) (Scaling, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Scaling{}, fmt.Errorf("error parsing scaling: %v", err)
+	}
+
+	max_instance_count, err := sdk.String(m["max_instance_count"])
+	if err != nil {
+		return Scaling{}, fmt.Errorf("error parsing scaling for cloud_run_service: %v", err)
+	}
+	min_instance_count, err := sdk.String(m["min_instance_count"])
+	if err != nil {
+		return Scaling{}, fmt.Errorf("error parsing scaling for cloud_run_service: %v", err)
+	}
+
+	return Scaling{
+		MaxInstanceCount: max_instance_count,
+		MinInstanceCount: min_instance_count,
+	}, nil
+}
+
+func ParseScalingList(v any) ([]Scaling, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Scaling
+	for _, val := range list {
+		p, err := ParseScaling(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
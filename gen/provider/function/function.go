@@ -245,16 +245,20 @@ func ParseBuildConfigList(v any) ([]BuildConfig, error) {
 }
 
 type Config struct {
-	BuildConfig BuildConfig
-	Description string
-	Labels      map[string]string
+	BuildConfig   BuildConfig
+	Description   string
+	EventTrigger  []EventTrigger
+	Labels        map[string]string
+	ServiceConfig ServiceConfig
 }
 
 func (x Config) ToValue() any {
 	return map[string]any{
-		"build_config": sdk.ToType[any](x.BuildConfig),
-		"description":  sdk.ToType[any](x.Description),
-		"labels":       sdk.ToType[string](x.Labels),
+		"build_config":   sdk.ToType[any](x.BuildConfig),
+		"description":    sdk.ToType[any](x.Description),
+		"event_trigger":  sdk.ToType[EventTrigger](x.EventTrigger),
+		"labels":         sdk.ToType[string](x.Labels),
+		"service_config": sdk.ToType[any](x.ServiceConfig),
 	}
 }
 
@@ -272,15 +276,25 @@ func ParseConfig(v any) (Config, error) {
 	if err != nil {
 		return Config{}, fmt.Errorf("error parsing config for function: %v", err)
 	}
+	event_trigger, err := ParseEventTriggerList(m["event_trigger"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for function: %v", err)
+	}
 	labels, err := sdk.Map[string](m["labels"])
 	if err != nil {
 		return Config{}, fmt.Errorf("error parsing config for function: %v", err)
 	}
+	service_config, err := ParseServiceConfig(m["service_config"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for function: %v", err)
+	}
 
 	return Config{
-		BuildConfig: build_config,
-		Description: description,
-		Labels:      labels,
+		BuildConfig:   build_config,
+		Description:   description,
+		EventTrigger:  event_trigger,
+		Labels:        labels,
+		ServiceConfig: service_config,
 	}, nil
 }
 
@@ -302,3 +316,382 @@ func ParseConfigList(v any) ([]Config, error) {
 
 	return vals, nil
 }
+
+type EventFilter struct {
+	Attribute string
+	Operator  string
+	Value     string
+}
+
+func (x EventFilter) ToValue() any {
+	return map[string]any{
+		"attribute": sdk.ToType[any](x.Attribute),
+		"operator":  sdk.ToType[any](x.Operator),
+		"value":     sdk.ToType[any](x.Value),
+	}
+}
+
+func ParseEventFilter(v any) (EventFilter, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return EventFilter{}, fmt.Errorf("error parsing event_filter: %v", err)
+	}
+
+	attribute, err := sdk.String(m["attribute"])
+	if err != nil {
+		return EventFilter{}, fmt.Errorf("error parsing event_filter for function: %v", err)
+	}
+	operator, err := sdk.String(m["operator"])
+	if err != nil {
+		return EventFilter{}, fmt.Errorf("error parsing event_filter for function: %v", err)
+	}
+	value, err := sdk.String(m["value"])
+	if err != nil {
+		return EventFilter{}, fmt.Errorf("error parsing event_filter for function: %v", err)
+	}
+
+	return EventFilter{
+		Attribute: attribute,
+		Operator:  operator,
+		Value:     value,
+	}, nil
+}
+
+func ParseEventFilterList(v any) ([]EventFilter, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []EventFilter
+	for _, val := range list {
+		p, err := ParseEventFilter(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type EventTrigger struct {
+	EventFilters   []EventFilter
+	EventType      string
+	PubsubTopic    []sdk.ResourceIdentifier
+	RetryPolicy    string
+	ServiceAccount sdk.ResourceIdentifier
+	TriggerRegion  string
+}
+
+func (x EventTrigger) ToValue() any {
+	return map[string]any{
+		"event_filters":   sdk.ToType[EventFilter](x.EventFilters),
+		"event_type":      sdk.ToType[any](x.EventType),
+		"pubsub_topic":    sdk.ToType[sdk.ResourceIdentifier](x.PubsubTopic),
+		"retry_policy":    sdk.ToType[any](x.RetryPolicy),
+		"service_account": sdk.ToType[any](x.ServiceAccount),
+		"trigger_region":  sdk.ToType[any](x.TriggerRegion),
+	}
+}
+
+func ParseEventTrigger(v any) (EventTrigger, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger: %v", err)
+	}
+
+	event_filters, err := ParseEventFilterList(m["event_filters"])
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger for function: %v", err)
+	}
+	event_type, err := sdk.String(m["event_type"])
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger for function: %v", err)
+	}
+	pubsub_topic, err := identifier.ParseIdentifierList(m["pubsub_topic"])
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger for function: %v", err)
+	}
+	retry_policy, err := sdk.String(m["retry_policy"])
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger for function: %v", err)
+	}
+	service_account, err := identifier.ParseIdentifier(m["service_account"])
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger for function: %v", err)
+	}
+	trigger_region, err := sdk.String(m["trigger_region"])
+	if err != nil {
+		return EventTrigger{}, fmt.Errorf("error parsing event_trigger for function: %v", err)
+	}
+
+	return EventTrigger{
+		EventFilters:   event_filters,
+		EventType:      event_type,
+		PubsubTopic:    pubsub_topic,
+		RetryPolicy:    retry_policy,
+		ServiceAccount: service_account,
+		TriggerRegion:  trigger_region,
+	}, nil
+}
+
+func ParseEventTriggerList(v any) ([]EventTrigger, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []EventTrigger
+	for _, val := range list {
+		p, err := ParseEventTrigger(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type SecretEnvironmentVariable struct {
+	Key     string
+	Secret  sdk.ResourceIdentifier
+	Version string
+}
+
+func (x SecretEnvironmentVariable) ToValue() any {
+	return map[string]any{
+		"key":     sdk.ToType[any](x.Key),
+		"secret":  sdk.ToType[any](x.Secret),
+		"version": sdk.ToType[any](x.Version),
+	}
+}
+
+func ParseSecretEnvironmentVariable(v any) (SecretEnvironmentVariable, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return SecretEnvironmentVariable{}, fmt.Errorf("error parsing secret_environment_variable: %v", err)
+	}
+
+	key, err := sdk.String(m["key"])
+	if err != nil {
+		return SecretEnvironmentVariable{}, fmt.Errorf("error parsing secret_environment_variable for function: %v", err)
+	}
+	secret, err := identifier.ParseIdentifier(m["secret"])
+	if err != nil {
+		return SecretEnvironmentVariable{}, fmt.Errorf("error parsing secret_environment_variable for function: %v", err)
+	}
+	version, err := sdk.String(m["version"])
+	if err != nil {
+		return SecretEnvironmentVariable{}, fmt.Errorf("error parsing secret_environment_variable for function: %v", err)
+	}
+
+	return SecretEnvironmentVariable{
+		Key:     key,
+		Secret:  secret,
+		Version: version,
+	}, nil
+}
+
+func ParseSecretEnvironmentVariableList(v any) ([]SecretEnvironmentVariable, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []SecretEnvironmentVariable
+	for _, val := range list {
+		p, err := ParseSecretEnvironmentVariable(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type SecretVolume struct {
+	MountPath string
+	Path      string
+	Secret    sdk.ResourceIdentifier
+	Version   string
+}
+
+func (x SecretVolume) ToValue() any {
+	return map[string]any{
+		"mount_path": sdk.ToType[any](x.MountPath),
+		"path":       sdk.ToType[any](x.Path),
+		"secret":     sdk.ToType[any](x.Secret),
+		"version":    sdk.ToType[any](x.Version),
+	}
+}
+
+func ParseSecretVolume(v any) (SecretVolume, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return SecretVolume{}, fmt.Errorf("error parsing secret_volume: %v", err)
+	}
+
+	mount_path, err := sdk.String(m["mount_path"])
+	if err != nil {
+		return SecretVolume{}, fmt.Errorf("error parsing secret_volume for function: %v", err)
+	}
+	path, err := sdk.String(m["path"])
+	if err != nil {
+		return SecretVolume{}, fmt.Errorf("error parsing secret_volume for function: %v", err)
+	}
+	secret, err := identifier.ParseIdentifier(m["secret"])
+	if err != nil {
+		return SecretVolume{}, fmt.Errorf("error parsing secret_volume for function: %v", err)
+	}
+	version, err := sdk.String(m["version"])
+	if err != nil {
+		return SecretVolume{}, fmt.Errorf("error parsing secret_volume for function: %v", err)
+	}
+
+	return SecretVolume{
+		MountPath: mount_path,
+		Path:      path,
+		Secret:    secret,
+		Version:   version,
+	}, nil
+}
+
+func ParseSecretVolumeList(v any) ([]SecretVolume, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []SecretVolume
+	for _, val := range list {
+		p, err := ParseSecretVolume(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type ServiceConfig struct {
+	AvailableMemory               string
+	EnvironmentVariables          map[string]string
+	IngressSettings               string
+	MaxInstanceCount              string
+	MaxInstanceRequestConcurrency string
+	MinInstanceCount              string
+	SecretEnvironmentVariables    []SecretEnvironmentVariable
+	SecretVolumes                 []SecretVolume
+	ServiceAccount                sdk.ResourceIdentifier
+	TimeoutSeconds                string
+	VpcConnector                  string
+}
+
+func (x ServiceConfig) ToValue() any {
+	return map[string]any{
+		"available_memory":                 sdk.ToType[any](x.AvailableMemory),
+		"environment_variables":            sdk.ToType[string](x.EnvironmentVariables),
+		"ingress_settings":                 sdk.ToType[any](x.IngressSettings),
+		"max_instance_count":               sdk.ToType[any](x.MaxInstanceCount),
+		"max_instance_request_concurrency": sdk.ToType[any](x.MaxInstanceRequestConcurrency),
+		"min_instance_count":               sdk.ToType[any](x.MinInstanceCount),
+		"secret_environment_variables":     sdk.ToType[SecretEnvironmentVariable](x.SecretEnvironmentVariables),
+		"secret_volumes":                   sdk.ToType[SecretVolume](x.SecretVolumes),
+		"service_account":                  sdk.ToType[any](x.ServiceAccount),
+		"timeout_seconds":                  sdk.ToType[any](x.TimeoutSeconds),
+		"vpc_connector":                    sdk.ToType[any](x.VpcConnector),
+	}
+}
+
+func ParseServiceConfig(v any) (ServiceConfig, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config: %v", err)
+	}
+
+	available_memory, err := sdk.String(m["available_memory"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	environment_variables, err := sdk.Map[string](m["environment_variables"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	ingress_settings, err := sdk.String(m["ingress_settings"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	max_instance_count, err := sdk.String(m["max_instance_count"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	max_instance_request_concurrency, err := sdk.String(m["max_instance_request_concurrency"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	min_instance_count, err := sdk.String(m["min_instance_count"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	secret_environment_variables, err := ParseSecretEnvironmentVariableList(m["secret_environment_variables"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	secret_volumes, err := ParseSecretVolumeList(m["secret_volumes"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	service_account, err := identifier.ParseIdentifier(m["service_account"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	timeout_seconds, err := sdk.String(m["timeout_seconds"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+	vpc_connector, err := sdk.String(m["vpc_connector"])
+	if err != nil {
+		return ServiceConfig{}, fmt.Errorf("error parsing service_config for function: %v", err)
+	}
+
+	return ServiceConfig{
+		AvailableMemory:               available_memory,
+		EnvironmentVariables:          environment_variables,
+		IngressSettings:               ingress_settings,
+		MaxInstanceCount:              max_instance_count,
+		MaxInstanceRequestConcurrency: max_instance_request_concurrency,
+		MinInstanceCount:              min_instance_count,
+		SecretEnvironmentVariables:    secret_environment_variables,
+		SecretVolumes:                 secret_volumes,
+		ServiceAccount:                service_account,
+		TimeoutSeconds:                timeout_seconds,
+		VpcConnector:                  vpc_connector,
+	}, nil
+}
+
+func ParseServiceConfigList(v any) ([]ServiceConfig, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []ServiceConfig
+	for _, val := range list {
+		p, err := ParseServiceConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,231 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_binding
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamBinding struct {
+	Identifier identifier.IamBindingIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamBinding) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamBindingGetter interface {
+	GetIamBinding(context.Context, identifier.IamBindingIdentifier) (IamBinding, error)
+}
+
+type IamBindingCreator interface {
+	CreateIamBinding(context.Context, identifier.IamBindingIdentifier, Config) (IamBinding, error)
+}
+
+type IamBindingUpdator interface {
+	UpdateIamBinding(context.Context, identifier.IamBindingIdentifier, Config, []sdk.UpdateMaskField) (IamBinding, error)
+}
+
+type IamBindingDeleter interface {
+	DeleteIamBinding(context.Context, identifier.IamBindingIdentifier) error
+}
+
+type IamBindingHandler struct {
+	IamBindingGetter  IamBindingGetter
+	IamBindingCreator IamBindingCreator
+	IamBindingUpdator IamBindingUpdator
+	IamBindingDeleter IamBindingDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamBindingHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamBindingGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamBindingIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamBindingGetter.GetIamBinding(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamBindingHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamBindingCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamBindingIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamBindingCreator.CreateIamBinding(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamBindingHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamBindingUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamBindingIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamBindingUpdator.UpdateIamBinding(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamBindingHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamBindingDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamBindingIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamBindingDeleter.DeleteIamBinding(ctx, idVal)
+}
+
+func (h *IamBindingHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	Etag string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"etag": sdk.ToType[any](x.Etag),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	etag, err := sdk.String(m["etag"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for iam_binding: %v", err)
+	}
+
+	return Attrs{
+		Etag: etag,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	Members []sdk.ResourceIdentifier
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"members": sdk.ToType[sdk.ResourceIdentifier](x.Members),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	members, err := identifier.ParseIdentifierList(m["members"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for iam_binding: %v", err)
+	}
+
+	return Config{
+		Members: members,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
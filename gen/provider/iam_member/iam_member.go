@@ -0,0 +1,217 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMember struct {
+	Identifier identifier.IamMemberIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMember) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberGetter interface {
+	GetIamMember(context.Context, identifier.IamMemberIdentifier) (IamMember, error)
+}
+
+type IamMemberCreator interface {
+	CreateIamMember(context.Context, identifier.IamMemberIdentifier, Config) (IamMember, error)
+}
+
+type IamMemberUpdator interface {
+	UpdateIamMember(context.Context, identifier.IamMemberIdentifier, Config, []sdk.UpdateMaskField) (IamMember, error)
+}
+
+type IamMemberDeleter interface {
+	DeleteIamMember(context.Context, identifier.IamMemberIdentifier) error
+}
+
+type IamMemberHandler struct {
+	IamMemberGetter  IamMemberGetter
+	IamMemberCreator IamMemberCreator
+	IamMemberUpdator IamMemberUpdator
+	IamMemberDeleter IamMemberDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGetter.GetIamMember(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberCreator.CreateIamMember(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberUpdator.UpdateIamMember(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberDeleter.DeleteIamMember(ctx, idVal)
+}
+
+func (h *IamMemberHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	Etag string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"etag": sdk.ToType[any](x.Etag),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	etag, err := sdk.String(m["etag"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for iam_member: %v", err)
+	}
+
+	return Attrs{
+		Etag: etag,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
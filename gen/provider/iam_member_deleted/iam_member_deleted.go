@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_deleted
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMemberDeleted struct {
+	Identifier identifier.IamMemberDeletedIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMemberDeleted) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberDeletedGetter interface {
+	GetIamMemberDeleted(context.Context, identifier.IamMemberDeletedIdentifier) (IamMemberDeleted, error)
+}
+
+type IamMemberDeletedCreator interface {
+	CreateIamMemberDeleted(context.Context, identifier.IamMemberDeletedIdentifier, Config) (IamMemberDeleted, error)
+}
+
+type IamMemberDeletedUpdator interface {
+	UpdateIamMemberDeleted(context.Context, identifier.IamMemberDeletedIdentifier, Config, []sdk.UpdateMaskField) (IamMemberDeleted, error)
+}
+
+type IamMemberDeletedDeleter interface {
+	DeleteIamMemberDeleted(context.Context, identifier.IamMemberDeletedIdentifier) error
+}
+
+type IamMemberDeletedHandler struct {
+	IamMemberDeletedGetter  IamMemberDeletedGetter
+	IamMemberDeletedCreator IamMemberDeletedCreator
+	IamMemberDeletedUpdator IamMemberDeletedUpdator
+	IamMemberDeletedDeleter IamMemberDeletedDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberDeletedHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberDeletedGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDeletedIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberDeletedGetter.GetIamMemberDeleted(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberDeletedHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberDeletedCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDeletedIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberDeletedCreator.CreateIamMemberDeleted(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberDeletedHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberDeletedUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDeletedIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberDeletedUpdator.UpdateIamMemberDeleted(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberDeletedHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberDeletedDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDeletedIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberDeletedDeleter.DeleteIamMemberDeleted(ctx, idVal)
+}
+
+func (h *IamMemberDeletedHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
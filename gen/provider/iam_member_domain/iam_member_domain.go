@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_domain
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMemberDomain struct {
+	Identifier identifier.IamMemberDomainIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMemberDomain) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberDomainGetter interface {
+	GetIamMemberDomain(context.Context, identifier.IamMemberDomainIdentifier) (IamMemberDomain, error)
+}
+
+type IamMemberDomainCreator interface {
+	CreateIamMemberDomain(context.Context, identifier.IamMemberDomainIdentifier, Config) (IamMemberDomain, error)
+}
+
+type IamMemberDomainUpdator interface {
+	UpdateIamMemberDomain(context.Context, identifier.IamMemberDomainIdentifier, Config, []sdk.UpdateMaskField) (IamMemberDomain, error)
+}
+
+type IamMemberDomainDeleter interface {
+	DeleteIamMemberDomain(context.Context, identifier.IamMemberDomainIdentifier) error
+}
+
+type IamMemberDomainHandler struct {
+	IamMemberDomainGetter  IamMemberDomainGetter
+	IamMemberDomainCreator IamMemberDomainCreator
+	IamMemberDomainUpdator IamMemberDomainUpdator
+	IamMemberDomainDeleter IamMemberDomainDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberDomainHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberDomainGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDomainIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberDomainGetter.GetIamMemberDomain(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberDomainHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberDomainCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDomainIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberDomainCreator.CreateIamMemberDomain(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberDomainHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberDomainUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDomainIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberDomainUpdator.UpdateIamMemberDomain(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberDomainHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberDomainDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberDomainIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberDomainDeleter.DeleteIamMemberDomain(ctx, idVal)
+}
+
+func (h *IamMemberDomainHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_google_service_account
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMemberGoogleServiceAccount struct {
+	Identifier identifier.IamMemberGoogleServiceAccountIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMemberGoogleServiceAccount) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberGoogleServiceAccountGetter interface {
+	GetIamMemberGoogleServiceAccount(context.Context, identifier.IamMemberGoogleServiceAccountIdentifier) (IamMemberGoogleServiceAccount, error)
+}
+
+type IamMemberGoogleServiceAccountCreator interface {
+	CreateIamMemberGoogleServiceAccount(context.Context, identifier.IamMemberGoogleServiceAccountIdentifier, Config) (IamMemberGoogleServiceAccount, error)
+}
+
+type IamMemberGoogleServiceAccountUpdator interface {
+	UpdateIamMemberGoogleServiceAccount(context.Context, identifier.IamMemberGoogleServiceAccountIdentifier, Config, []sdk.UpdateMaskField) (IamMemberGoogleServiceAccount, error)
+}
+
+type IamMemberGoogleServiceAccountDeleter interface {
+	DeleteIamMemberGoogleServiceAccount(context.Context, identifier.IamMemberGoogleServiceAccountIdentifier) error
+}
+
+type IamMemberGoogleServiceAccountHandler struct {
+	IamMemberGoogleServiceAccountGetter  IamMemberGoogleServiceAccountGetter
+	IamMemberGoogleServiceAccountCreator IamMemberGoogleServiceAccountCreator
+	IamMemberGoogleServiceAccountUpdator IamMemberGoogleServiceAccountUpdator
+	IamMemberGoogleServiceAccountDeleter IamMemberGoogleServiceAccountDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberGoogleServiceAccountHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberGoogleServiceAccountGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGoogleServiceAccountIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGoogleServiceAccountGetter.GetIamMemberGoogleServiceAccount(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberGoogleServiceAccountHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberGoogleServiceAccountCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGoogleServiceAccountIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGoogleServiceAccountCreator.CreateIamMemberGoogleServiceAccount(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberGoogleServiceAccountHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberGoogleServiceAccountUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGoogleServiceAccountIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGoogleServiceAccountUpdator.UpdateIamMemberGoogleServiceAccount(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberGoogleServiceAccountHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberGoogleServiceAccountDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGoogleServiceAccountIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberGoogleServiceAccountDeleter.DeleteIamMemberGoogleServiceAccount(ctx, idVal)
+}
+
+func (h *IamMemberGoogleServiceAccountHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
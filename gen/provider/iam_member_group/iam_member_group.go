@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_group
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMemberGroup struct {
+	Identifier identifier.IamMemberGroupIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMemberGroup) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberGroupGetter interface {
+	GetIamMemberGroup(context.Context, identifier.IamMemberGroupIdentifier) (IamMemberGroup, error)
+}
+
+type IamMemberGroupCreator interface {
+	CreateIamMemberGroup(context.Context, identifier.IamMemberGroupIdentifier, Config) (IamMemberGroup, error)
+}
+
+type IamMemberGroupUpdator interface {
+	UpdateIamMemberGroup(context.Context, identifier.IamMemberGroupIdentifier, Config, []sdk.UpdateMaskField) (IamMemberGroup, error)
+}
+
+type IamMemberGroupDeleter interface {
+	DeleteIamMemberGroup(context.Context, identifier.IamMemberGroupIdentifier) error
+}
+
+type IamMemberGroupHandler struct {
+	IamMemberGroupGetter  IamMemberGroupGetter
+	IamMemberGroupCreator IamMemberGroupCreator
+	IamMemberGroupUpdator IamMemberGroupUpdator
+	IamMemberGroupDeleter IamMemberGroupDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberGroupHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberGroupGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGroupIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGroupGetter.GetIamMemberGroup(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberGroupHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberGroupCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGroupIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGroupCreator.CreateIamMemberGroup(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberGroupHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberGroupUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGroupIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberGroupUpdator.UpdateIamMemberGroup(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberGroupHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberGroupDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberGroupIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberGroupDeleter.DeleteIamMemberGroup(ctx, idVal)
+}
+
+func (h *IamMemberGroupHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
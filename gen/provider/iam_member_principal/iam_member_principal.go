@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_principal
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMemberPrincipal struct {
+	Identifier identifier.IamMemberPrincipalIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMemberPrincipal) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberPrincipalGetter interface {
+	GetIamMemberPrincipal(context.Context, identifier.IamMemberPrincipalIdentifier) (IamMemberPrincipal, error)
+}
+
+type IamMemberPrincipalCreator interface {
+	CreateIamMemberPrincipal(context.Context, identifier.IamMemberPrincipalIdentifier, Config) (IamMemberPrincipal, error)
+}
+
+type IamMemberPrincipalUpdator interface {
+	UpdateIamMemberPrincipal(context.Context, identifier.IamMemberPrincipalIdentifier, Config, []sdk.UpdateMaskField) (IamMemberPrincipal, error)
+}
+
+type IamMemberPrincipalDeleter interface {
+	DeleteIamMemberPrincipal(context.Context, identifier.IamMemberPrincipalIdentifier) error
+}
+
+type IamMemberPrincipalHandler struct {
+	IamMemberPrincipalGetter  IamMemberPrincipalGetter
+	IamMemberPrincipalCreator IamMemberPrincipalCreator
+	IamMemberPrincipalUpdator IamMemberPrincipalUpdator
+	IamMemberPrincipalDeleter IamMemberPrincipalDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberPrincipalHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberPrincipalGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberPrincipalIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberPrincipalGetter.GetIamMemberPrincipal(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberPrincipalHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberPrincipalCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberPrincipalIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberPrincipalCreator.CreateIamMemberPrincipal(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberPrincipalHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberPrincipalUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberPrincipalIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberPrincipalUpdator.UpdateIamMemberPrincipal(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberPrincipalHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberPrincipalDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberPrincipalIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberPrincipalDeleter.DeleteIamMemberPrincipal(ctx, idVal)
+}
+
+func (h *IamMemberPrincipalHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_project_role
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamMemberProjectRole struct {
+	Identifier identifier.IamMemberProjectRoleIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamMemberProjectRole) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamMemberProjectRoleGetter interface {
+	GetIamMemberProjectRole(context.Context, identifier.IamMemberProjectRoleIdentifier) (IamMemberProjectRole, error)
+}
+
+type IamMemberProjectRoleCreator interface {
+	CreateIamMemberProjectRole(context.Context, identifier.IamMemberProjectRoleIdentifier, Config) (IamMemberProjectRole, error)
+}
+
+type IamMemberProjectRoleUpdator interface {
+	UpdateIamMemberProjectRole(context.Context, identifier.IamMemberProjectRoleIdentifier, Config, []sdk.UpdateMaskField) (IamMemberProjectRole, error)
+}
+
+type IamMemberProjectRoleDeleter interface {
+	DeleteIamMemberProjectRole(context.Context, identifier.IamMemberProjectRoleIdentifier) error
+}
+
+type IamMemberProjectRoleHandler struct {
+	IamMemberProjectRoleGetter  IamMemberProjectRoleGetter
+	IamMemberProjectRoleCreator IamMemberProjectRoleCreator
+	IamMemberProjectRoleUpdator IamMemberProjectRoleUpdator
+	IamMemberProjectRoleDeleter IamMemberProjectRoleDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamMemberProjectRoleHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamMemberProjectRoleGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberProjectRoleIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberProjectRoleGetter.GetIamMemberProjectRole(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberProjectRoleHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamMemberProjectRoleCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberProjectRoleIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberProjectRoleCreator.CreateIamMemberProjectRole(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberProjectRoleHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamMemberProjectRoleUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberProjectRoleIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamMemberProjectRoleUpdator.UpdateIamMemberProjectRole(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamMemberProjectRoleHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamMemberProjectRoleDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamMemberProjectRoleIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamMemberProjectRoleDeleter.DeleteIamMemberProjectRole(ctx, idVal)
+}
+
+func (h *IamMemberProjectRoleHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -186,14 +186,16 @@ func ParseAttrsList(v any) ([]Attrs, error) {
 }
 
 type Binding struct {
-	Members []sdk.ResourceIdentifier
-	Role    sdk.ResourceIdentifier
+	Condition Condition
+	Members   []sdk.ResourceIdentifier
+	Role      sdk.ResourceIdentifier
 }
 
 func (x Binding) ToValue() any {
 	return map[string]any{
-		"members": sdk.ToType[sdk.ResourceIdentifier](x.Members),
-		"role":    sdk.ToType[any](x.Role),
+		"condition": sdk.ToType[any](x.Condition),
+		"members":   sdk.ToType[sdk.ResourceIdentifier](x.Members),
+		"role":      sdk.ToType[any](x.Role),
 	}
 }
 
@@ -203,6 +205,10 @@ func ParseBinding(v any) (Binding, error) {
 		return Binding{}, fmt.Errorf("error parsing binding: %v", err)
 	}
 
+	condition, err := ParseCondition(m["condition"])
+	if err != nil {
+		return Binding{}, fmt.Errorf("error parsing binding for iam_policy: %v", err)
+	}
 	members, err := identifier.ParseIdentifierList(m["members"])
 	if err != nil {
 		return Binding{}, fmt.Errorf("error parsing binding for iam_policy: %v", err)
@@ -213,8 +219,9 @@ func ParseBinding(v any) (Binding, error) {
 	}
 
 	return Binding{
-		Members: members,
-		Role:    role,
+		Condition: condition,
+		Members:   members,
+		Role:      role,
 	}, nil
 }
 
@@ -237,6 +244,65 @@ func ParseBindingList(v any) ([]Binding, error) {
 	return vals, nil
 }
 
+type Condition struct {
+	Description string
+	Expression  string
+	Title       string
+}
+
+func (x Condition) ToValue() any {
+	return map[string]any{
+		"description": sdk.ToType[any](x.Description),
+		"expression":  sdk.ToType[any](x.Expression),
+		"title":       sdk.ToType[any](x.Title),
+	}
+}
+
+func ParseCondition(v any) (Condition, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Condition{}, fmt.Errorf("error parsing condition: %v", err)
+	}
+
+	description, err := sdk.String(m["description"])
+	if err != nil {
+		return Condition{}, fmt.Errorf("error parsing condition for iam_policy: %v", err)
+	}
+	expression, err := sdk.String(m["expression"])
+	if err != nil {
+		return Condition{}, fmt.Errorf("error parsing condition for iam_policy: %v", err)
+	}
+	title, err := sdk.String(m["title"])
+	if err != nil {
+		return Condition{}, fmt.Errorf("error parsing condition for iam_policy: %v", err)
+	}
+
+	return Condition{
+		Description: description,
+		Expression:  expression,
+		Title:       title,
+	}, nil
+}
+
+func ParseConditionList(v any) ([]Condition, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Condition
+	for _, val := range list {
+		p, err := ParseCondition(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
 type Config struct {
 	Bindings []Binding
 }
@@ -0,0 +1,217 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_role_query
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type IamRoleQuery struct {
+	Identifier identifier.IamRoleQueryIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x IamRoleQuery) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type IamRoleQueryGetter interface {
+	GetIamRoleQuery(context.Context, identifier.IamRoleQueryIdentifier) (IamRoleQuery, error)
+}
+
+type IamRoleQueryCreator interface {
+	CreateIamRoleQuery(context.Context, identifier.IamRoleQueryIdentifier, Config) (IamRoleQuery, error)
+}
+
+type IamRoleQueryUpdator interface {
+	UpdateIamRoleQuery(context.Context, identifier.IamRoleQueryIdentifier, Config, []sdk.UpdateMaskField) (IamRoleQuery, error)
+}
+
+type IamRoleQueryDeleter interface {
+	DeleteIamRoleQuery(context.Context, identifier.IamRoleQueryIdentifier) error
+}
+
+type IamRoleQueryHandler struct {
+	IamRoleQueryGetter  IamRoleQueryGetter
+	IamRoleQueryCreator IamRoleQueryCreator
+	IamRoleQueryUpdator IamRoleQueryUpdator
+	IamRoleQueryDeleter IamRoleQueryDeleter
+
+	CloseFunc func() error
+}
+
+func (h *IamRoleQueryHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.IamRoleQueryGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamRoleQueryIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamRoleQueryGetter.GetIamRoleQuery(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamRoleQueryHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.IamRoleQueryCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamRoleQueryIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamRoleQueryCreator.CreateIamRoleQuery(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamRoleQueryHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.IamRoleQueryUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamRoleQueryIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.IamRoleQueryUpdator.UpdateIamRoleQuery(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *IamRoleQueryHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.IamRoleQueryDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseIamRoleQueryIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.IamRoleQueryDeleter.DeleteIamRoleQuery(ctx, idVal)
+}
+
+func (h *IamRoleQueryHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	Roles []sdk.ResourceIdentifier
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"roles": sdk.ToType[sdk.ResourceIdentifier](x.Roles),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	roles, err := identifier.ParseIdentifierList(m["roles"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for iam_role_query: %v", err)
+	}
+
+	return Attrs{
+		Roles: roles,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
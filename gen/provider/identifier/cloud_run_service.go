@@ -0,0 +1,58 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type CloudRunServiceIdentifier struct {
+	Location string
+	Name     string
+	Project  string
+}
+
+func (x CloudRunServiceIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "cloud_run_service",
+		Value: map[string]any{
+			"location": sdk.ToType[any](x.Location),
+			"name":     sdk.ToType[any](x.Name),
+			"project":  sdk.ToType[any](x.Project),
+		},
+	}
+}
+
+func (x CloudRunServiceIdentifier) ResourceType() string {
+	return "cloud_run_service"
+}
+
+func ParseCloudRunServiceIdentifier(v sdk.Identifier) (CloudRunServiceIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return CloudRunServiceIdentifier{}, fmt.Errorf("error parsing cloud_run_service_identifier: %v", err)
+	}
+
+	location, err := sdk.String(m["location"])
+	if err != nil {
+		return CloudRunServiceIdentifier{}, fmt.Errorf("error parsing cloud_run_service_identifier: %v", err)
+	}
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return CloudRunServiceIdentifier{}, fmt.Errorf("error parsing cloud_run_service_identifier: %v", err)
+	}
+	project, err := sdk.String(m["project"])
+	if err != nil {
+		return CloudRunServiceIdentifier{}, fmt.Errorf("error parsing cloud_run_service_identifier: %v", err)
+	}
+
+	return CloudRunServiceIdentifier{
+		Location: location,
+		Name:     name,
+		Project:  project,
+	}, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamBindingIdentifier struct {
+	ConditionDescription string
+	ConditionExpression  string
+	ConditionTitle       string
+	Resource             sdk.ResourceIdentifier
+	Role                 sdk.ResourceIdentifier
+}
+
+func (x IamBindingIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_binding",
+		Value: map[string]any{
+			"condition_description": sdk.ToType[any](x.ConditionDescription),
+			"condition_expression":  sdk.ToType[any](x.ConditionExpression),
+			"condition_title":       sdk.ToType[any](x.ConditionTitle),
+			"resource":              sdk.ToType[any](x.Resource),
+			"role":                  sdk.ToType[any](x.Role),
+		},
+	}
+}
+
+func (x IamBindingIdentifier) ResourceType() string {
+	return "iam_binding"
+}
+
+func ParseIamBindingIdentifier(v sdk.Identifier) (IamBindingIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamBindingIdentifier{}, fmt.Errorf("error parsing iam_binding_identifier: %v", err)
+	}
+
+	condition_description, err := sdk.String(m["condition_description"])
+	if err != nil {
+		return IamBindingIdentifier{}, fmt.Errorf("error parsing iam_binding_identifier: %v", err)
+	}
+	condition_expression, err := sdk.String(m["condition_expression"])
+	if err != nil {
+		return IamBindingIdentifier{}, fmt.Errorf("error parsing iam_binding_identifier: %v", err)
+	}
+	condition_title, err := sdk.String(m["condition_title"])
+	if err != nil {
+		return IamBindingIdentifier{}, fmt.Errorf("error parsing iam_binding_identifier: %v", err)
+	}
+	resource, err := ParseIdentifier(m["resource"])
+	if err != nil {
+		return IamBindingIdentifier{}, fmt.Errorf("error parsing iam_binding_identifier: %v", err)
+	}
+	role, err := ParseIdentifier(m["role"])
+	if err != nil {
+		return IamBindingIdentifier{}, fmt.Errorf("error parsing iam_binding_identifier: %v", err)
+	}
+
+	return IamBindingIdentifier{
+		ConditionDescription: condition_description,
+		ConditionExpression:  condition_expression,
+		ConditionTitle:       condition_title,
+		Resource:             resource,
+		Role:                 role,
+	}, nil
+}
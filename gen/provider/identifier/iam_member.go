@@ -0,0 +1,79 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberIdentifier struct {
+	ConditionDescription string
+	ConditionExpression  string
+	ConditionTitle       string
+	Member               sdk.ResourceIdentifier
+	Resource             sdk.ResourceIdentifier
+	Role                 sdk.ResourceIdentifier
+}
+
+func (x IamMemberIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member",
+		Value: map[string]any{
+			"condition_description": sdk.ToType[any](x.ConditionDescription),
+			"condition_expression":  sdk.ToType[any](x.ConditionExpression),
+			"condition_title":       sdk.ToType[any](x.ConditionTitle),
+			"member":                sdk.ToType[any](x.Member),
+			"resource":              sdk.ToType[any](x.Resource),
+			"role":                  sdk.ToType[any](x.Role),
+		},
+	}
+}
+
+func (x IamMemberIdentifier) ResourceType() string {
+	return "iam_member"
+}
+
+func ParseIamMemberIdentifier(v sdk.Identifier) (IamMemberIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+
+	condition_description, err := sdk.String(m["condition_description"])
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+	condition_expression, err := sdk.String(m["condition_expression"])
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+	condition_title, err := sdk.String(m["condition_title"])
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+	member, err := ParseIdentifier(m["member"])
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+	resource, err := ParseIdentifier(m["resource"])
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+	role, err := ParseIdentifier(m["role"])
+	if err != nil {
+		return IamMemberIdentifier{}, fmt.Errorf("error parsing iam_member_identifier: %v", err)
+	}
+
+	return IamMemberIdentifier{
+		ConditionDescription: condition_description,
+		ConditionExpression:  condition_expression,
+		ConditionTitle:       condition_title,
+		Member:               member,
+		Resource:             resource,
+		Role:                 role,
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberDeletedIdentifier struct {
+	Member string
+	Uid    string
+}
+
+func (x IamMemberDeletedIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_deleted",
+		Value: map[string]any{
+			"member": sdk.ToType[any](x.Member),
+			"uid":    sdk.ToType[any](x.Uid),
+		},
+	}
+}
+
+func (x IamMemberDeletedIdentifier) ResourceType() string {
+	return "iam_member_deleted"
+}
+
+func ParseIamMemberDeletedIdentifier(v sdk.Identifier) (IamMemberDeletedIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberDeletedIdentifier{}, fmt.Errorf("error parsing iam_member_deleted_identifier: %v", err)
+	}
+
+	member, err := sdk.String(m["member"])
+	if err != nil {
+		return IamMemberDeletedIdentifier{}, fmt.Errorf("error parsing iam_member_deleted_identifier: %v", err)
+	}
+	uid, err := sdk.String(m["uid"])
+	if err != nil {
+		return IamMemberDeletedIdentifier{}, fmt.Errorf("error parsing iam_member_deleted_identifier: %v", err)
+	}
+
+	return IamMemberDeletedIdentifier{
+		Member: member,
+		Uid:    uid,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberDomainIdentifier struct {
+	Domain string
+}
+
+func (x IamMemberDomainIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_domain",
+		Value: map[string]any{
+			"domain": sdk.ToType[any](x.Domain),
+		},
+	}
+}
+
+func (x IamMemberDomainIdentifier) ResourceType() string {
+	return "iam_member_domain"
+}
+
+func ParseIamMemberDomainIdentifier(v sdk.Identifier) (IamMemberDomainIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberDomainIdentifier{}, fmt.Errorf("error parsing iam_member_domain_identifier: %v", err)
+	}
+
+	domain, err := sdk.String(m["domain"])
+	if err != nil {
+		return IamMemberDomainIdentifier{}, fmt.Errorf("error parsing iam_member_domain_identifier: %v", err)
+	}
+
+	return IamMemberDomainIdentifier{
+		Domain: domain,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberGoogleServiceAccountIdentifier struct {
+	Email string
+}
+
+func (x IamMemberGoogleServiceAccountIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_google_service_account",
+		Value: map[string]any{
+			"email": sdk.ToType[any](x.Email),
+		},
+	}
+}
+
+func (x IamMemberGoogleServiceAccountIdentifier) ResourceType() string {
+	return "iam_member_google_service_account"
+}
+
+func ParseIamMemberGoogleServiceAccountIdentifier(v sdk.Identifier) (IamMemberGoogleServiceAccountIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberGoogleServiceAccountIdentifier{}, fmt.Errorf("error parsing iam_member_google_service_account_identifier: %v", err)
+	}
+
+	email, err := sdk.String(m["email"])
+	if err != nil {
+		return IamMemberGoogleServiceAccountIdentifier{}, fmt.Errorf("error parsing iam_member_google_service_account_identifier: %v", err)
+	}
+
+	return IamMemberGoogleServiceAccountIdentifier{
+		Email: email,
+	}, nil
+}
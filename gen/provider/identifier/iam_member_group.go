@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberGroupIdentifier struct {
+	Email string
+}
+
+func (x IamMemberGroupIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_group",
+		Value: map[string]any{
+			"email": sdk.ToType[any](x.Email),
+		},
+	}
+}
+
+func (x IamMemberGroupIdentifier) ResourceType() string {
+	return "iam_member_group"
+}
+
+func ParseIamMemberGroupIdentifier(v sdk.Identifier) (IamMemberGroupIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberGroupIdentifier{}, fmt.Errorf("error parsing iam_member_group_identifier: %v", err)
+	}
+
+	email, err := sdk.String(m["email"])
+	if err != nil {
+		return IamMemberGroupIdentifier{}, fmt.Errorf("error parsing iam_member_group_identifier: %v", err)
+	}
+
+	return IamMemberGroupIdentifier{
+		Email: email,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberPrincipalIdentifier struct {
+	Uri string
+}
+
+func (x IamMemberPrincipalIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_principal",
+		Value: map[string]any{
+			"uri": sdk.ToType[any](x.Uri),
+		},
+	}
+}
+
+func (x IamMemberPrincipalIdentifier) ResourceType() string {
+	return "iam_member_principal"
+}
+
+func ParseIamMemberPrincipalIdentifier(v sdk.Identifier) (IamMemberPrincipalIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberPrincipalIdentifier{}, fmt.Errorf("error parsing iam_member_principal_identifier: %v", err)
+	}
+
+	uri, err := sdk.String(m["uri"])
+	if err != nil {
+		return IamMemberPrincipalIdentifier{}, fmt.Errorf("error parsing iam_member_principal_identifier: %v", err)
+	}
+
+	return IamMemberPrincipalIdentifier{
+		Uri: uri,
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberProjectRoleIdentifier struct {
+	Project string
+	Role    string
+}
+
+func (x IamMemberProjectRoleIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_project_role",
+		Value: map[string]any{
+			"project": sdk.ToType[any](x.Project),
+			"role":    sdk.ToType[any](x.Role),
+		},
+	}
+}
+
+func (x IamMemberProjectRoleIdentifier) ResourceType() string {
+	return "iam_member_project_role"
+}
+
+func ParseIamMemberProjectRoleIdentifier(v sdk.Identifier) (IamMemberProjectRoleIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberProjectRoleIdentifier{}, fmt.Errorf("error parsing iam_member_project_role_identifier: %v", err)
+	}
+
+	project, err := sdk.String(m["project"])
+	if err != nil {
+		return IamMemberProjectRoleIdentifier{}, fmt.Errorf("error parsing iam_member_project_role_identifier: %v", err)
+	}
+	role, err := sdk.String(m["role"])
+	if err != nil {
+		return IamMemberProjectRoleIdentifier{}, fmt.Errorf("error parsing iam_member_project_role_identifier: %v", err)
+	}
+
+	return IamMemberProjectRoleIdentifier{
+		Project: project,
+		Role:    role,
+	}, nil
+}
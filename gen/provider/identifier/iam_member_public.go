@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberPublicIdentifier struct {
+	Principal string
+}
+
+func (x IamMemberPublicIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_public",
+		Value: map[string]any{
+			"principal": sdk.ToType[any](x.Principal),
+		},
+	}
+}
+
+func (x IamMemberPublicIdentifier) ResourceType() string {
+	return "iam_member_public"
+}
+
+func ParseIamMemberPublicIdentifier(v sdk.Identifier) (IamMemberPublicIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberPublicIdentifier{}, fmt.Errorf("error parsing iam_member_public_identifier: %v", err)
+	}
+
+	principal, err := sdk.String(m["principal"])
+	if err != nil {
+		return IamMemberPublicIdentifier{}, fmt.Errorf("error parsing iam_member_public_identifier: %v", err)
+	}
+
+	return IamMemberPublicIdentifier{
+		Principal: principal,
+	}, nil
+}
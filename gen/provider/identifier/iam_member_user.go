@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamMemberUserIdentifier struct {
+	Email string
+}
+
+func (x IamMemberUserIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_member_user",
+		Value: map[string]any{
+			"email": sdk.ToType[any](x.Email),
+		},
+	}
+}
+
+func (x IamMemberUserIdentifier) ResourceType() string {
+	return "iam_member_user"
+}
+
+func ParseIamMemberUserIdentifier(v sdk.Identifier) (IamMemberUserIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamMemberUserIdentifier{}, fmt.Errorf("error parsing iam_member_user_identifier: %v", err)
+	}
+
+	email, err := sdk.String(m["email"])
+	if err != nil {
+		return IamMemberUserIdentifier{}, fmt.Errorf("error parsing iam_member_user_identifier: %v", err)
+	}
+
+	return IamMemberUserIdentifier{
+		Email: email,
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamRoleCustomOrganizationIdentifier struct {
+	Name         string
+	Organization string
+}
+
+func (x IamRoleCustomOrganizationIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_role_custom_organization",
+		Value: map[string]any{
+			"name":         sdk.ToType[any](x.Name),
+			"organization": sdk.ToType[any](x.Organization),
+		},
+	}
+}
+
+func (x IamRoleCustomOrganizationIdentifier) ResourceType() string {
+	return "iam_role_custom_organization"
+}
+
+func ParseIamRoleCustomOrganizationIdentifier(v sdk.Identifier) (IamRoleCustomOrganizationIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamRoleCustomOrganizationIdentifier{}, fmt.Errorf("error parsing iam_role_custom_organization_identifier: %v", err)
+	}
+
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return IamRoleCustomOrganizationIdentifier{}, fmt.Errorf("error parsing iam_role_custom_organization_identifier: %v", err)
+	}
+	organization, err := sdk.String(m["organization"])
+	if err != nil {
+		return IamRoleCustomOrganizationIdentifier{}, fmt.Errorf("error parsing iam_role_custom_organization_identifier: %v", err)
+	}
+
+	return IamRoleCustomOrganizationIdentifier{
+		Name:         name,
+		Organization: organization,
+	}, nil
+}
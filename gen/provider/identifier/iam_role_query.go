@@ -0,0 +1,58 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type IamRoleQueryIdentifier struct {
+	Permissions []string
+	Resource    sdk.ResourceIdentifier
+	Title       string
+}
+
+func (x IamRoleQueryIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "iam_role_query",
+		Value: map[string]any{
+			"permissions": sdk.ToType[string](x.Permissions),
+			"resource":    sdk.ToType[any](x.Resource),
+			"title":       sdk.ToType[any](x.Title),
+		},
+	}
+}
+
+func (x IamRoleQueryIdentifier) ResourceType() string {
+	return "iam_role_query"
+}
+
+func ParseIamRoleQueryIdentifier(v sdk.Identifier) (IamRoleQueryIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return IamRoleQueryIdentifier{}, fmt.Errorf("error parsing iam_role_query_identifier: %v", err)
+	}
+
+	permissions, err := sdk.List[string](m["permissions"])
+	if err != nil {
+		return IamRoleQueryIdentifier{}, fmt.Errorf("error parsing iam_role_query_identifier: %v", err)
+	}
+	resource, err := ParseIdentifier(m["resource"])
+	if err != nil {
+		return IamRoleQueryIdentifier{}, fmt.Errorf("error parsing iam_role_query_identifier: %v", err)
+	}
+	title, err := sdk.String(m["title"])
+	if err != nil {
+		return IamRoleQueryIdentifier{}, fmt.Errorf("error parsing iam_role_query_identifier: %v", err)
+	}
+
+	return IamRoleQueryIdentifier{
+		Permissions: permissions,
+		Resource:    resource,
+		Title:       title,
+	}, nil
+}
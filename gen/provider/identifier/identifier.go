@@ -26,16 +26,58 @@ func ParseIdentifier(v any) (sdk.ResourceIdentifier, error) {
 		return ParseBucketIdentifier(id)
 	case "bucket_object":
 		return ParseBucketObjectIdentifier(id)
+	case "cloud_run_job":
+		return ParseCloudRunJobIdentifier(id)
+	case "cloud_run_service":
+		return ParseCloudRunServiceIdentifier(id)
 	case "function":
 		return ParseFunctionIdentifier(id)
+	case "iam_binding":
+		return ParseIamBindingIdentifier(id)
+	case "iam_member":
+		return ParseIamMemberIdentifier(id)
+	case "iam_member_deleted":
+		return ParseIamMemberDeletedIdentifier(id)
+	case "iam_member_domain":
+		return ParseIamMemberDomainIdentifier(id)
+	case "iam_member_google_service_account":
+		return ParseIamMemberGoogleServiceAccountIdentifier(id)
+	case "iam_member_group":
+		return ParseIamMemberGroupIdentifier(id)
+	case "iam_member_principal":
+		return ParseIamMemberPrincipalIdentifier(id)
+	case "iam_member_project_role":
+		return ParseIamMemberProjectRoleIdentifier(id)
+	case "iam_member_public":
+		return ParseIamMemberPublicIdentifier(id)
+	case "iam_member_user":
+		return ParseIamMemberUserIdentifier(id)
 	case "iam_policy":
 		return ParseIamPolicyIdentifier(id)
 	case "iam_role":
 		return ParseIamRoleIdentifier(id)
+	case "iam_role_custom_organization":
+		return ParseIamRoleCustomOrganizationIdentifier(id)
 	case "iam_role_custom_project":
 		return ParseIamRoleCustomProjectIdentifier(id)
+	case "iam_role_query":
+		return ParseIamRoleQueryIdentifier(id)
+	case "kms_crypto_key":
+		return ParseKmsCryptoKeyIdentifier(id)
+	case "project":
+		return ParseProjectIdentifier(id)
+	case "pubsub_subscription":
+		return ParsePubsubSubscriptionIdentifier(id)
+	case "pubsub_topic":
+		return ParsePubsubTopicIdentifier(id)
+	case "secret":
+		return ParseSecretIdentifier(id)
+	case "secret_version":
+		return ParseSecretVersionIdentifier(id)
 	case "service_account":
 		return ParseServiceAccountIdentifier(id)
+	case "service_account_key":
+		return ParseServiceAccountKeyIdentifier(id)
 
 	default:
 		return nil, fmt.Errorf("invalid resource type: %s", id.ResourceType)
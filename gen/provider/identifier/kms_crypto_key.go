@@ -0,0 +1,65 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type KmsCryptoKeyIdentifier struct {
+	KeyRing  string
+	Location string
+	Name     string
+	Project  string
+}
+
+func (x KmsCryptoKeyIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "kms_crypto_key",
+		Value: map[string]any{
+			"key_ring": sdk.ToType[any](x.KeyRing),
+			"location": sdk.ToType[any](x.Location),
+			"name":     sdk.ToType[any](x.Name),
+			"project":  sdk.ToType[any](x.Project),
+		},
+	}
+}
+
+func (x KmsCryptoKeyIdentifier) ResourceType() string {
+	return "kms_crypto_key"
+}
+
+func ParseKmsCryptoKeyIdentifier(v sdk.Identifier) (KmsCryptoKeyIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return KmsCryptoKeyIdentifier{}, fmt.Errorf("error parsing kms_crypto_key_identifier: %v", err)
+	}
+
+	key_ring, err := sdk.String(m["key_ring"])
+	if err != nil {
+		return KmsCryptoKeyIdentifier{}, fmt.Errorf("error parsing kms_crypto_key_identifier: %v", err)
+	}
+	location, err := sdk.String(m["location"])
+	if err != nil {
+		return KmsCryptoKeyIdentifier{}, fmt.Errorf("error parsing kms_crypto_key_identifier: %v", err)
+	}
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return KmsCryptoKeyIdentifier{}, fmt.Errorf("error parsing kms_crypto_key_identifier: %v", err)
+	}
+	project, err := sdk.String(m["project"])
+	if err != nil {
+		return KmsCryptoKeyIdentifier{}, fmt.Errorf("error parsing kms_crypto_key_identifier: %v", err)
+	}
+
+	return KmsCryptoKeyIdentifier{
+		KeyRing:  key_ring,
+		Location: location,
+		Name:     name,
+		Project:  project,
+	}, nil
+}
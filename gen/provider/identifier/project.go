@@ -0,0 +1,44 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type ProjectIdentifier struct {
+	ProjectId string
+}
+
+func (x ProjectIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "project",
+		Value: map[string]any{
+			"project_id": sdk.ToType[any](x.ProjectId),
+		},
+	}
+}
+
+func (x ProjectIdentifier) ResourceType() string {
+	return "project"
+}
+
+func ParseProjectIdentifier(v sdk.Identifier) (ProjectIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return ProjectIdentifier{}, fmt.Errorf("error parsing project_identifier: %v", err)
+	}
+
+	project_id, err := sdk.String(m["project_id"])
+	if err != nil {
+		return ProjectIdentifier{}, fmt.Errorf("error parsing project_identifier: %v", err)
+	}
+
+	return ProjectIdentifier{
+		ProjectId: project_id,
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type PubsubSubscriptionIdentifier struct {
+	Name  string
+	Topic sdk.ResourceIdentifier
+}
+
+func (x PubsubSubscriptionIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "pubsub_subscription",
+		Value: map[string]any{
+			"name":  sdk.ToType[any](x.Name),
+			"topic": sdk.ToType[any](x.Topic),
+		},
+	}
+}
+
+func (x PubsubSubscriptionIdentifier) ResourceType() string {
+	return "pubsub_subscription"
+}
+
+func ParsePubsubSubscriptionIdentifier(v sdk.Identifier) (PubsubSubscriptionIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return PubsubSubscriptionIdentifier{}, fmt.Errorf("error parsing pubsub_subscription_identifier: %v", err)
+	}
+
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return PubsubSubscriptionIdentifier{}, fmt.Errorf("error parsing pubsub_subscription_identifier: %v", err)
+	}
+	topic, err := ParseIdentifier(m["topic"])
+	if err != nil {
+		return PubsubSubscriptionIdentifier{}, fmt.Errorf("error parsing pubsub_subscription_identifier: %v", err)
+	}
+
+	return PubsubSubscriptionIdentifier{
+		Name:  name,
+		Topic: topic,
+	}, nil
+}
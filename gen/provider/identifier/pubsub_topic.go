@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type PubsubTopicIdentifier struct {
+	Name    string
+	Project string
+}
+
+func (x PubsubTopicIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "pubsub_topic",
+		Value: map[string]any{
+			"name":    sdk.ToType[any](x.Name),
+			"project": sdk.ToType[any](x.Project),
+		},
+	}
+}
+
+func (x PubsubTopicIdentifier) ResourceType() string {
+	return "pubsub_topic"
+}
+
+func ParsePubsubTopicIdentifier(v sdk.Identifier) (PubsubTopicIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return PubsubTopicIdentifier{}, fmt.Errorf("error parsing pubsub_topic_identifier: %v", err)
+	}
+
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return PubsubTopicIdentifier{}, fmt.Errorf("error parsing pubsub_topic_identifier: %v", err)
+	}
+	project, err := sdk.String(m["project"])
+	if err != nil {
+		return PubsubTopicIdentifier{}, fmt.Errorf("error parsing pubsub_topic_identifier: %v", err)
+	}
+
+	return PubsubTopicIdentifier{
+		Name:    name,
+		Project: project,
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type SecretIdentifier struct {
+	Name    string
+	Project string
+}
+
+func (x SecretIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "secret",
+		Value: map[string]any{
+			"name":    sdk.ToType[any](x.Name),
+			"project": sdk.ToType[any](x.Project),
+		},
+	}
+}
+
+func (x SecretIdentifier) ResourceType() string {
+	return "secret"
+}
+
+func ParseSecretIdentifier(v sdk.Identifier) (SecretIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return SecretIdentifier{}, fmt.Errorf("error parsing secret_identifier: %v", err)
+	}
+
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return SecretIdentifier{}, fmt.Errorf("error parsing secret_identifier: %v", err)
+	}
+	project, err := sdk.String(m["project"])
+	if err != nil {
+		return SecretIdentifier{}, fmt.Errorf("error parsing secret_identifier: %v", err)
+	}
+
+	return SecretIdentifier{
+		Name:    name,
+		Project: project,
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type SecretVersionIdentifier struct {
+	Name   string
+	Secret sdk.ResourceIdentifier
+}
+
+func (x SecretVersionIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "secret_version",
+		Value: map[string]any{
+			"name":   sdk.ToType[any](x.Name),
+			"secret": sdk.ToType[any](x.Secret),
+		},
+	}
+}
+
+func (x SecretVersionIdentifier) ResourceType() string {
+	return "secret_version"
+}
+
+func ParseSecretVersionIdentifier(v sdk.Identifier) (SecretVersionIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return SecretVersionIdentifier{}, fmt.Errorf("error parsing secret_version_identifier: %v", err)
+	}
+
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return SecretVersionIdentifier{}, fmt.Errorf("error parsing secret_version_identifier: %v", err)
+	}
+	secret, err := ParseIdentifier(m["secret"])
+	if err != nil {
+		return SecretVersionIdentifier{}, fmt.Errorf("error parsing secret_version_identifier: %v", err)
+	}
+
+	return SecretVersionIdentifier{
+		Name:   name,
+		Secret: secret,
+	}, nil
+}
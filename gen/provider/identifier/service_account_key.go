@@ -0,0 +1,51 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package identifier
+
+import (
+	"fmt"
+
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+type ServiceAccountKeyIdentifier struct {
+	Name           string
+	ServiceAccount sdk.ResourceIdentifier
+}
+
+func (x ServiceAccountKeyIdentifier) ToValue() sdk.Identifier {
+	return sdk.Identifier{
+		ResourceType: "service_account_key",
+		Value: map[string]any{
+			"name":            sdk.ToType[any](x.Name),
+			"service_account": sdk.ToType[any](x.ServiceAccount),
+		},
+	}
+}
+
+func (x ServiceAccountKeyIdentifier) ResourceType() string {
+	return "service_account_key"
+}
+
+func ParseServiceAccountKeyIdentifier(v sdk.Identifier) (ServiceAccountKeyIdentifier, error) {
+
+	m, err := sdk.Map[any](v.Value)
+	if err != nil {
+		return ServiceAccountKeyIdentifier{}, fmt.Errorf("error parsing service_account_key_identifier: %v", err)
+	}
+
+	name, err := sdk.String(m["name"])
+	if err != nil {
+		return ServiceAccountKeyIdentifier{}, fmt.Errorf("error parsing service_account_key_identifier: %v", err)
+	}
+	service_account, err := ParseIdentifier(m["service_account"])
+	if err != nil {
+		return ServiceAccountKeyIdentifier{}, fmt.Errorf("error parsing service_account_key_identifier: %v", err)
+	}
+
+	return ServiceAccountKeyIdentifier{
+		Name:           name,
+		ServiceAccount: service_account,
+	}, nil
+}
@@ -0,0 +1,203 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package kms_crypto_key
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type KmsCryptoKey struct {
+	Identifier identifier.KmsCryptoKeyIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x KmsCryptoKey) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type KmsCryptoKeyGetter interface {
+	GetKmsCryptoKey(context.Context, identifier.KmsCryptoKeyIdentifier) (KmsCryptoKey, error)
+}
+
+type KmsCryptoKeyCreator interface {
+	CreateKmsCryptoKey(context.Context, identifier.KmsCryptoKeyIdentifier, Config) (KmsCryptoKey, error)
+}
+
+type KmsCryptoKeyUpdator interface {
+	UpdateKmsCryptoKey(context.Context, identifier.KmsCryptoKeyIdentifier, Config, []sdk.UpdateMaskField) (KmsCryptoKey, error)
+}
+
+type KmsCryptoKeyDeleter interface {
+	DeleteKmsCryptoKey(context.Context, identifier.KmsCryptoKeyIdentifier) error
+}
+
+type KmsCryptoKeyHandler struct {
+	KmsCryptoKeyGetter  KmsCryptoKeyGetter
+	KmsCryptoKeyCreator KmsCryptoKeyCreator
+	KmsCryptoKeyUpdator KmsCryptoKeyUpdator
+	KmsCryptoKeyDeleter KmsCryptoKeyDeleter
+
+	CloseFunc func() error
+}
+
+func (h *KmsCryptoKeyHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.KmsCryptoKeyGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseKmsCryptoKeyIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.KmsCryptoKeyGetter.GetKmsCryptoKey(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *KmsCryptoKeyHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.KmsCryptoKeyCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseKmsCryptoKeyIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.KmsCryptoKeyCreator.CreateKmsCryptoKey(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *KmsCryptoKeyHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.KmsCryptoKeyUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseKmsCryptoKeyIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.KmsCryptoKeyUpdator.UpdateKmsCryptoKey(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *KmsCryptoKeyHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.KmsCryptoKeyDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseKmsCryptoKeyIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.KmsCryptoKeyDeleter.DeleteKmsCryptoKey(ctx, idVal)
+}
+
+func (h *KmsCryptoKeyHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
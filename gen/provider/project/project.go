@@ -0,0 +1,245 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type Project struct {
+	Identifier identifier.ProjectIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x Project) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type ProjectGetter interface {
+	GetProject(context.Context, identifier.ProjectIdentifier) (Project, error)
+}
+
+type ProjectCreator interface {
+	CreateProject(context.Context, identifier.ProjectIdentifier, Config) (Project, error)
+}
+
+type ProjectUpdator interface {
+	UpdateProject(context.Context, identifier.ProjectIdentifier, Config, []sdk.UpdateMaskField) (Project, error)
+}
+
+type ProjectDeleter interface {
+	DeleteProject(context.Context, identifier.ProjectIdentifier) error
+}
+
+type ProjectHandler struct {
+	ProjectGetter  ProjectGetter
+	ProjectCreator ProjectCreator
+	ProjectUpdator ProjectUpdator
+	ProjectDeleter ProjectDeleter
+
+	CloseFunc func() error
+}
+
+func (h *ProjectHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.ProjectGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseProjectIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.ProjectGetter.GetProject(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *ProjectHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.ProjectCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseProjectIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.ProjectCreator.CreateProject(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *ProjectHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.ProjectUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseProjectIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.ProjectUpdator.UpdateProject(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *ProjectHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.ProjectDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseProjectIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.ProjectDeleter.DeleteProject(ctx, idVal)
+}
+
+func (h *ProjectHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	DisplayName   string
+	Etag          string
+	Parent        string
+	ProjectNumber string
+	State         string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"display_name":   sdk.ToType[any](x.DisplayName),
+		"etag":           sdk.ToType[any](x.Etag),
+		"parent":         sdk.ToType[any](x.Parent),
+		"project_number": sdk.ToType[any](x.ProjectNumber),
+		"state":          sdk.ToType[any](x.State),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	display_name, err := sdk.String(m["display_name"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for project: %v", err)
+	}
+	etag, err := sdk.String(m["etag"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for project: %v", err)
+	}
+	parent, err := sdk.String(m["parent"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for project: %v", err)
+	}
+	project_number, err := sdk.String(m["project_number"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for project: %v", err)
+	}
+	state, err := sdk.String(m["state"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for project: %v", err)
+	}
+
+	return Attrs{
+		DisplayName:   display_name,
+		Etag:          etag,
+		Parent:        parent,
+		ProjectNumber: project_number,
+		State:         state,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseConfig(v any) (Config, error) {
+
+	return Config{}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,363 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package pubsub_subscription
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type PubsubSubscription struct {
+	Identifier identifier.PubsubSubscriptionIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x PubsubSubscription) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type PubsubSubscriptionGetter interface {
+	GetPubsubSubscription(context.Context, identifier.PubsubSubscriptionIdentifier) (PubsubSubscription, error)
+}
+
+type PubsubSubscriptionCreator interface {
+	CreatePubsubSubscription(context.Context, identifier.PubsubSubscriptionIdentifier, Config) (PubsubSubscription, error)
+}
+
+type PubsubSubscriptionUpdator interface {
+	UpdatePubsubSubscription(context.Context, identifier.PubsubSubscriptionIdentifier, Config, []sdk.UpdateMaskField) (PubsubSubscription, error)
+}
+
+type PubsubSubscriptionDeleter interface {
+	DeletePubsubSubscription(context.Context, identifier.PubsubSubscriptionIdentifier) error
+}
+
+type PubsubSubscriptionHandler struct {
+	PubsubSubscriptionGetter  PubsubSubscriptionGetter
+	PubsubSubscriptionCreator PubsubSubscriptionCreator
+	PubsubSubscriptionUpdator PubsubSubscriptionUpdator
+	PubsubSubscriptionDeleter PubsubSubscriptionDeleter
+
+	CloseFunc func() error
+}
+
+func (h *PubsubSubscriptionHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.PubsubSubscriptionGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubSubscriptionIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.PubsubSubscriptionGetter.GetPubsubSubscription(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *PubsubSubscriptionHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.PubsubSubscriptionCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubSubscriptionIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.PubsubSubscriptionCreator.CreatePubsubSubscription(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *PubsubSubscriptionHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.PubsubSubscriptionUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubSubscriptionIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.PubsubSubscriptionUpdator.UpdatePubsubSubscription(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *PubsubSubscriptionHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.PubsubSubscriptionDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubSubscriptionIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.PubsubSubscriptionDeleter.DeletePubsubSubscription(ctx, idVal)
+}
+
+func (h *PubsubSubscriptionHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	AckDeadlineSeconds        string
+	DeadLetterPolicy          []DeadLetterPolicy
+	EnableExactlyOnceDelivery bool
+	Filter                    string
+	Labels                    map[string]string
+	PushEndpoint              string
+	RetryPolicy               RetryPolicy
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"ack_deadline_seconds":         sdk.ToType[any](x.AckDeadlineSeconds),
+		"dead_letter_policy":           sdk.ToType[DeadLetterPolicy](x.DeadLetterPolicy),
+		"enable_exactly_once_delivery": sdk.ToType[any](x.EnableExactlyOnceDelivery),
+		"filter":                       sdk.ToImmutableType(sdk.ToType[any])(x.Filter),
+		"labels":                       sdk.ToType[string](x.Labels),
+		"push_endpoint":                sdk.ToType[any](x.PushEndpoint),
+		"retry_policy":                 sdk.ToType[any](x.RetryPolicy),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	ack_deadline_seconds, err := sdk.String(m["ack_deadline_seconds"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+	dead_letter_policy, err := ParseDeadLetterPolicyList(m["dead_letter_policy"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+	enable_exactly_once_delivery, err := sdk.Bool(m["enable_exactly_once_delivery"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+	filter, err := sdk.String(m["filter"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+	labels, err := sdk.Map[string](m["labels"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+	push_endpoint, err := sdk.String(m["push_endpoint"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+	retry_policy, err := ParseRetryPolicy(m["retry_policy"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_subscription: %v", err)
+	}
+
+	return Config{
+		AckDeadlineSeconds:        ack_deadline_seconds,
+		DeadLetterPolicy:          dead_letter_policy,
+		EnableExactlyOnceDelivery: enable_exactly_once_delivery,
+		Filter:                    filter,
+		Labels:                    labels,
+		PushEndpoint:              push_endpoint,
+		RetryPolicy:               retry_policy,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type DeadLetterPolicy struct {
+	DeadLetterTopic     sdk.ResourceIdentifier
+	MaxDeliveryAttempts string
+}
+
+func (x DeadLetterPolicy) ToValue() any {
+	return map[string]any{
+		"dead_letter_topic":     sdk.ToType[any](x.DeadLetterTopic),
+		"max_delivery_attempts": sdk.ToType[any](x.MaxDeliveryAttempts),
+	}
+}
+
+func ParseDeadLetterPolicy(v any) (DeadLetterPolicy, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return DeadLetterPolicy{}, fmt.Errorf("error parsing dead_letter_policy: %v", err)
+	}
+
+	dead_letter_topic, err := identifier.ParseIdentifier(m["dead_letter_topic"])
+	if err != nil {
+		return DeadLetterPolicy{}, fmt.Errorf("error parsing dead_letter_policy for pubsub_subscription: %v", err)
+	}
+	max_delivery_attempts, err := sdk.String(m["max_delivery_attempts"])
+	if err != nil {
+		return DeadLetterPolicy{}, fmt.Errorf("error parsing dead_letter_policy for pubsub_subscription: %v", err)
+	}
+
+	return DeadLetterPolicy{
+		DeadLetterTopic:     dead_letter_topic,
+		MaxDeliveryAttempts: max_delivery_attempts,
+	}, nil
+}
+
+func ParseDeadLetterPolicyList(v any) ([]DeadLetterPolicy, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []DeadLetterPolicy
+	for _, val := range list {
+		p, err := ParseDeadLetterPolicy(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type RetryPolicy struct {
+	MaximumBackoff string
+	MinimumBackoff string
+}
+
+func (x RetryPolicy) ToValue() any {
+	return map[string]any{
+		"maximum_backoff": sdk.ToType[any](x.MaximumBackoff),
+		"minimum_backoff": sdk.ToType[any](x.MinimumBackoff),
+	}
+}
+
+func ParseRetryPolicy(v any) (RetryPolicy, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return RetryPolicy{}, fmt.Errorf("error parsing retry_policy: %v", err)
+	}
+
+	maximum_backoff, err := sdk.String(m["maximum_backoff"])
+	if err != nil {
+		return RetryPolicy{}, fmt.Errorf("error parsing retry_policy for pubsub_subscription: %v", err)
+	}
+	minimum_backoff, err := sdk.String(m["minimum_backoff"])
+	if err != nil {
+		return RetryPolicy{}, fmt.Errorf("error parsing retry_policy for pubsub_subscription: %v", err)
+	}
+
+	return RetryPolicy{
+		MaximumBackoff: maximum_backoff,
+		MinimumBackoff: minimum_backoff,
+	}, nil
+}
+
+func ParseRetryPolicyList(v any) ([]RetryPolicy, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []RetryPolicy
+	for _, val := range list {
+		p, err := ParseRetryPolicy(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,290 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package pubsub_topic
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type PubsubTopic struct {
+	Identifier identifier.PubsubTopicIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x PubsubTopic) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type PubsubTopicGetter interface {
+	GetPubsubTopic(context.Context, identifier.PubsubTopicIdentifier) (PubsubTopic, error)
+}
+
+type PubsubTopicCreator interface {
+	CreatePubsubTopic(context.Context, identifier.PubsubTopicIdentifier, Config) (PubsubTopic, error)
+}
+
+type PubsubTopicUpdator interface {
+	UpdatePubsubTopic(context.Context, identifier.PubsubTopicIdentifier, Config, []sdk.UpdateMaskField) (PubsubTopic, error)
+}
+
+type PubsubTopicDeleter interface {
+	DeletePubsubTopic(context.Context, identifier.PubsubTopicIdentifier) error
+}
+
+type PubsubTopicHandler struct {
+	PubsubTopicGetter  PubsubTopicGetter
+	PubsubTopicCreator PubsubTopicCreator
+	PubsubTopicUpdator PubsubTopicUpdator
+	PubsubTopicDeleter PubsubTopicDeleter
+
+	CloseFunc func() error
+}
+
+func (h *PubsubTopicHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.PubsubTopicGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubTopicIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.PubsubTopicGetter.GetPubsubTopic(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *PubsubTopicHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.PubsubTopicCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubTopicIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.PubsubTopicCreator.CreatePubsubTopic(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *PubsubTopicHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.PubsubTopicUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubTopicIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.PubsubTopicUpdator.UpdatePubsubTopic(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *PubsubTopicHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.PubsubTopicDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParsePubsubTopicIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.PubsubTopicDeleter.DeletePubsubTopic(ctx, idVal)
+}
+
+func (h *PubsubTopicHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+
+	return Attrs{}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	KmsKeyName               string
+	Labels                   map[string]string
+	MessageRetentionDuration string
+	SchemaSettings           SchemaSettings
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"kms_key_name":               sdk.ToType[any](x.KmsKeyName),
+		"labels":                     sdk.ToType[string](x.Labels),
+		"message_retention_duration": sdk.ToType[any](x.MessageRetentionDuration),
+		"schema_settings":            sdk.ToType[any](x.SchemaSettings),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	kms_key_name, err := sdk.String(m["kms_key_name"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_topic: %v", err)
+	}
+	labels, err := sdk.Map[string](m["labels"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_topic: %v", err)
+	}
+	message_retention_duration, err := sdk.String(m["message_retention_duration"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_topic: %v", err)
+	}
+	schema_settings, err := ParseSchemaSettings(m["schema_settings"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for pubsub_topic: %v", err)
+	}
+
+	return Config{
+		KmsKeyName:               kms_key_name,
+		Labels:                   labels,
+		MessageRetentionDuration: message_retention_duration,
+		SchemaSettings:           schema_settings,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type SchemaSettings struct {
+	Encoding string
+	Schema   string
+}
+
+func (x SchemaSettings) ToValue() any {
+	return map[string]any{
+		"encoding": sdk.ToType[any](x.Encoding),
+		"schema":   sdk.ToType[any](x.Schema),
+	}
+}
+
+func ParseSchemaSettings(v any) (SchemaSettings, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return SchemaSettings{}, fmt.Errorf("error parsing schema_settings: %v", err)
+	}
+
+	encoding, err := sdk.String(m["encoding"])
+	if err != nil {
+		return SchemaSettings{}, fmt.Errorf("error parsing schema_settings for pubsub_topic: %v", err)
+	}
+	schema, err := sdk.String(m["schema"])
+	if err != nil {
+		return SchemaSettings{}, fmt.Errorf("error parsing schema_settings for pubsub_topic: %v", err)
+	}
+
+	return SchemaSettings{
+		Encoding: encoding,
+		Schema:   schema,
+	}, nil
+}
+
+func ParseSchemaSettingsList(v any) ([]SchemaSettings, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []SchemaSettings
+	for _, val := range list {
+		p, err := ParseSchemaSettings(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
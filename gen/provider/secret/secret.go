@@ -0,0 +1,287 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type Secret struct {
+	Identifier identifier.SecretIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x Secret) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type SecretGetter interface {
+	GetSecret(context.Context, identifier.SecretIdentifier) (Secret, error)
+}
+
+type SecretCreator interface {
+	CreateSecret(context.Context, identifier.SecretIdentifier, Config) (Secret, error)
+}
+
+type SecretUpdator interface {
+	UpdateSecret(context.Context, identifier.SecretIdentifier, Config, []sdk.UpdateMaskField) (Secret, error)
+}
+
+type SecretDeleter interface {
+	DeleteSecret(context.Context, identifier.SecretIdentifier) error
+}
+
+type SecretHandler struct {
+	SecretGetter  SecretGetter
+	SecretCreator SecretCreator
+	SecretUpdator SecretUpdator
+	SecretDeleter SecretDeleter
+
+	CloseFunc func() error
+}
+
+func (h *SecretHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.SecretGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.SecretGetter.GetSecret(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *SecretHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.SecretCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.SecretCreator.CreateSecret(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *SecretHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.SecretUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.SecretUpdator.UpdateSecret(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *SecretHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.SecretDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.SecretDeleter.DeleteSecret(ctx, idVal)
+}
+
+func (h *SecretHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	Create           string
+	Etag             string
+	ExpireTime       string
+	NextRotationTime string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"create":             sdk.ToType[any](x.Create),
+		"etag":               sdk.ToType[any](x.Etag),
+		"expire_time":        sdk.ToType[any](x.ExpireTime),
+		"next_rotation_time": sdk.ToType[any](x.NextRotationTime),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	create, err := sdk.String(m["create"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret: %v", err)
+	}
+	etag, err := sdk.String(m["etag"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret: %v", err)
+	}
+	expire_time, err := sdk.String(m["expire_time"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret: %v", err)
+	}
+	next_rotation_time, err := sdk.String(m["next_rotation_time"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret: %v", err)
+	}
+
+	return Attrs{
+		Create:           create,
+		Etag:             etag,
+		ExpireTime:       expire_time,
+		NextRotationTime: next_rotation_time,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	Annotations          map[string]string
+	Labels               map[string]string
+	ReplicationLocations []string
+	RotationPeriod       string
+	Topics               []sdk.ResourceIdentifier
+	Ttl                  string
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"annotations":           sdk.ToType[string](x.Annotations),
+		"labels":                sdk.ToType[string](x.Labels),
+		"replication_locations": sdk.ToImmutableType(sdk.ToType[string])(x.ReplicationLocations),
+		"rotation_period":       sdk.ToType[any](x.RotationPeriod),
+		"topics":                sdk.ToType[sdk.ResourceIdentifier](x.Topics),
+		"ttl":                   sdk.ToImmutableType(sdk.ToType[any])(x.Ttl),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	annotations, err := sdk.Map[string](m["annotations"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret: %v", err)
+	}
+	labels, err := sdk.Map[string](m["labels"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret: %v", err)
+	}
+	replication_locations, err := sdk.List[string](m["replication_locations"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret: %v", err)
+	}
+	rotation_period, err := sdk.String(m["rotation_period"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret: %v", err)
+	}
+	topics, err := identifier.ParseIdentifierList(m["topics"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret: %v", err)
+	}
+	ttl, err := sdk.String(m["ttl"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret: %v", err)
+	}
+
+	return Config{
+		Annotations:          annotations,
+		Labels:               labels,
+		ReplicationLocations: replication_locations,
+		RotationPeriod:       rotation_period,
+		Topics:               topics,
+		Ttl:                  ttl,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
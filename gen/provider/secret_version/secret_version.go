@@ -0,0 +1,252 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package secret_version
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type SecretVersion struct {
+	Identifier identifier.SecretVersionIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x SecretVersion) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type SecretVersionGetter interface {
+	GetSecretVersion(context.Context, identifier.SecretVersionIdentifier) (SecretVersion, error)
+}
+
+type SecretVersionCreator interface {
+	CreateSecretVersion(context.Context, identifier.SecretVersionIdentifier, Config) (SecretVersion, error)
+}
+
+type SecretVersionUpdator interface {
+	UpdateSecretVersion(context.Context, identifier.SecretVersionIdentifier, Config, []sdk.UpdateMaskField) (SecretVersion, error)
+}
+
+type SecretVersionDeleter interface {
+	DeleteSecretVersion(context.Context, identifier.SecretVersionIdentifier) error
+}
+
+type SecretVersionHandler struct {
+	SecretVersionGetter  SecretVersionGetter
+	SecretVersionCreator SecretVersionCreator
+	SecretVersionUpdator SecretVersionUpdator
+	SecretVersionDeleter SecretVersionDeleter
+
+	CloseFunc func() error
+}
+
+func (h *SecretVersionHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.SecretVersionGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretVersionIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.SecretVersionGetter.GetSecretVersion(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *SecretVersionHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.SecretVersionCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretVersionIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.SecretVersionCreator.CreateSecretVersion(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *SecretVersionHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.SecretVersionUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretVersionIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.SecretVersionUpdator.UpdateSecretVersion(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *SecretVersionHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.SecretVersionDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseSecretVersionIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.SecretVersionDeleter.DeleteSecretVersion(ctx, idVal)
+}
+
+func (h *SecretVersionHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	Create  string
+	State   string
+	Version string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"create":  sdk.ToType[any](x.Create),
+		"state":   sdk.ToType[any](x.State),
+		"version": sdk.ToType[any](x.Version),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	create, err := sdk.String(m["create"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret_version: %v", err)
+	}
+	state, err := sdk.String(m["state"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret_version: %v", err)
+	}
+	version, err := sdk.String(m["version"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for secret_version: %v", err)
+	}
+
+	return Attrs{
+		Create:  create,
+		State:   state,
+		Version: version,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	Contents        sdk.File
+	DestroyOnDelete bool
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"contents":          sdk.ToType[any](x.Contents),
+		"destroy_on_delete": sdk.ToType[any](x.DestroyOnDelete),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	contents, err := sdk.ParseFile(m["contents"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret_version: %v", err)
+	}
+	destroy_on_delete, err := sdk.Bool(m["destroy_on_delete"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for secret_version: %v", err)
+	}
+
+	return Config{
+		Contents:        contents,
+		DestroyOnDelete: destroy_on_delete,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
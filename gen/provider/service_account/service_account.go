@@ -141,13 +141,11 @@ func (h *ServiceAccountHandler) Close() error {
 }
 
 type Attrs struct {
-	Disabled bool
 	UniqueId string
 }
 
 func (x Attrs) ToValue() any {
 	return map[string]any{
-		"disabled":  sdk.ToType[any](x.Disabled),
 		"unique_id": sdk.ToType[any](x.UniqueId),
 	}
 }
@@ -158,17 +156,12 @@ func ParseAttrs(v any) (Attrs, error) {
 		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
 	}
 
-	disabled, err := sdk.Bool(m["disabled"])
-	if err != nil {
-		return Attrs{}, fmt.Errorf("error parsing attrs for service_account: %v", err)
-	}
 	unique_id, err := sdk.String(m["unique_id"])
 	if err != nil {
 		return Attrs{}, fmt.Errorf("error parsing attrs for service_account: %v", err)
 	}
 
 	return Attrs{
-		Disabled: disabled,
 		UniqueId: unique_id,
 	}, nil
 }
@@ -194,12 +187,14 @@ func ParseAttrsList(v any) ([]Attrs, error) {
 
 type Config struct {
 	Description string
+	Disabled    bool
 	DisplayName string
 }
 
 func (x Config) ToValue() any {
 	return map[string]any{
 		"description":  sdk.ToType[any](x.Description),
+		"disabled":     sdk.ToType[any](x.Disabled),
 		"display_name": sdk.ToType[any](x.DisplayName),
 	}
 }
@@ -214,6 +209,10 @@ func ParseConfig(v any) (Config, error) {
 	if err != nil {
 		return Config{}, fmt.Errorf("error parsing config for service_account: %v", err)
 	}
+	disabled, err := sdk.Bool(m["disabled"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for service_account: %v", err)
+	}
 	display_name, err := sdk.String(m["display_name"])
 	if err != nil {
 		return Config{}, fmt.Errorf("error parsing config for service_account: %v", err)
@@ -221,6 +220,7 @@ func ParseConfig(v any) (Config, error) {
 
 	return Config{
 		Description: description,
+		Disabled:    disabled,
 		DisplayName: display_name,
 	}, nil
 }
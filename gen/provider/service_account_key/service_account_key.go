@@ -0,0 +1,266 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package service_account_key
+
+import (
+	"context"
+	"fmt"
+	sdk "github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+)
+
+type ServiceAccountKey struct {
+	Identifier identifier.ServiceAccountKeyIdentifier
+	Config     Config
+	Attrs      Attrs
+}
+
+func (x ServiceAccountKey) ToResourceValue() (sdk.Resource, error) {
+	id := x.Identifier.ToValue()
+
+	config := x.Config.ToValue()
+
+	attrs := x.Attrs.ToValue()
+
+	return sdk.Resource{
+		Identifier: id,
+		Config:     config,
+		Attrs:      attrs,
+	}, nil
+}
+
+type ServiceAccountKeyGetter interface {
+	GetServiceAccountKey(context.Context, identifier.ServiceAccountKeyIdentifier) (ServiceAccountKey, error)
+}
+
+type ServiceAccountKeyCreator interface {
+	CreateServiceAccountKey(context.Context, identifier.ServiceAccountKeyIdentifier, Config) (ServiceAccountKey, error)
+}
+
+type ServiceAccountKeyUpdator interface {
+	UpdateServiceAccountKey(context.Context, identifier.ServiceAccountKeyIdentifier, Config, []sdk.UpdateMaskField) (ServiceAccountKey, error)
+}
+
+type ServiceAccountKeyDeleter interface {
+	DeleteServiceAccountKey(context.Context, identifier.ServiceAccountKeyIdentifier) error
+}
+
+type ServiceAccountKeyHandler struct {
+	ServiceAccountKeyGetter  ServiceAccountKeyGetter
+	ServiceAccountKeyCreator ServiceAccountKeyCreator
+	ServiceAccountKeyUpdator ServiceAccountKeyUpdator
+	ServiceAccountKeyDeleter ServiceAccountKeyDeleter
+
+	CloseFunc func() error
+}
+
+func (h *ServiceAccountKeyHandler) GetResource(ctx context.Context, id sdk.Identifier) (sdk.Resource, error) {
+	if h.ServiceAccountKeyGetter == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseServiceAccountKeyIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.ServiceAccountKeyGetter.GetServiceAccountKey(ctx, idVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *ServiceAccountKeyHandler) CreateResource(ctx context.Context, id sdk.Identifier, config any) (sdk.Resource, error) {
+	if h.ServiceAccountKeyCreator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseServiceAccountKeyIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.ServiceAccountKeyCreator.CreateServiceAccountKey(ctx, idVal, configVal)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *ServiceAccountKeyHandler) UpdateResource(ctx context.Context, id sdk.Identifier, config any, mask []sdk.UpdateMaskField) (sdk.Resource, error) {
+	if h.ServiceAccountKeyUpdator == nil {
+		return sdk.Resource{}, fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseServiceAccountKeyIdentifier(id)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	configVal, err := ParseConfig(config)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	r, err := h.ServiceAccountKeyUpdator.UpdateServiceAccountKey(ctx, idVal, configVal, mask)
+	if err != nil {
+		return sdk.Resource{}, err
+	}
+
+	return r.ToResourceValue()
+}
+
+func (h *ServiceAccountKeyHandler) DeleteResource(ctx context.Context, id sdk.Identifier) error {
+	if h.ServiceAccountKeyDeleter == nil {
+		return fmt.Errorf("unimplemented")
+	}
+
+	idVal, err := identifier.ParseServiceAccountKeyIdentifier(id)
+	if err != nil {
+		return err
+	}
+
+	return h.ServiceAccountKeyDeleter.DeleteServiceAccountKey(ctx, idVal)
+}
+
+func (h *ServiceAccountKeyHandler) Close() error {
+	if h.CloseFunc != nil {
+		return h.CloseFunc()
+	}
+
+	return nil
+}
+
+type Attrs struct {
+	KeyId       string
+	PrivateKey  string
+	ValidAfter  string
+	ValidBefore string
+}
+
+func (x Attrs) ToValue() any {
+	return map[string]any{
+		"key_id":       sdk.ToType[any](x.KeyId),
+		"private_key":  sdk.ToType[any](x.PrivateKey),
+		"valid_after":  sdk.ToType[any](x.ValidAfter),
+		"valid_before": sdk.ToType[any](x.ValidBefore),
+	}
+}
+
+func ParseAttrs(v any) (Attrs, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs: %v", err)
+	}
+
+	key_id, err := sdk.String(m["key_id"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for service_account_key: %v", err)
+	}
+	private_key, err := sdk.String(m["private_key"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for service_account_key: %v", err)
+	}
+	valid_after, err := sdk.String(m["valid_after"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for service_account_key: %v", err)
+	}
+	valid_before, err := sdk.String(m["valid_before"])
+	if err != nil {
+		return Attrs{}, fmt.Errorf("error parsing attrs for service_account_key: %v", err)
+	}
+
+	return Attrs{
+		KeyId:       key_id,
+		PrivateKey:  private_key,
+		ValidAfter:  valid_after,
+		ValidBefore: valid_before,
+	}, nil
+}
+
+func ParseAttrsList(v any) ([]Attrs, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Attrs
+	for _, val := range list {
+		p, err := ParseAttrs(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
+
+type Config struct {
+	Keepers       map[string]string
+	KeyAlgorithm  string
+	PublicKeyFile sdk.File
+}
+
+func (x Config) ToValue() any {
+	return map[string]any{
+		"keepers":         sdk.ToType[string](x.Keepers),
+		"key_algorithm":   sdk.ToType[any](x.KeyAlgorithm),
+		"public_key_file": sdk.ToType[any](x.PublicKeyFile),
+	}
+}
+
+func ParseConfig(v any) (Config, error) {
+	m, err := sdk.Map[any](v)
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config: %v", err)
+	}
+
+	keepers, err := sdk.Map[string](m["keepers"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for service_account_key: %v", err)
+	}
+	key_algorithm, err := sdk.String(m["key_algorithm"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for service_account_key: %v", err)
+	}
+	public_key_file, err := sdk.ParseFile(m["public_key_file"])
+	if err != nil {
+		return Config{}, fmt.Errorf("error parsing config for service_account_key: %v", err)
+	}
+
+	return Config{
+		Keepers:       keepers,
+		KeyAlgorithm:  key_algorithm,
+		PublicKeyFile: public_key_file,
+	}, nil
+}
+
+func ParseConfigList(v any) ([]Config, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for list: %T", v)
+	}
+
+	var vals []Config
+	for _, val := range list {
+		p, err := ParseConfig(val)
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, p)
+	}
+
+	return vals, nil
+}
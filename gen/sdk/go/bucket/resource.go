@@ -8,12 +8,54 @@ import (
 )
 
 type Config struct {
-	Labels any
+	Autoclass                any
+	Cors                     any
+	DefaultKmsKey            any
+	ForceDestroy             any
+	Labels                   any
+	LifecycleRules           any
+	PublicAccessPrevention   any
+	RequesterPays            any
+	RetentionPolicy          any
+	SoftDeleteRetention      any
+	StorageClass             any
+	UniformBucketLevelAccess any
+	Versioning               any
+	Website                  any
 }
 
 func (x Config) ToExpr() any {
 	return map[string]any{
-		"labels": x.Labels,
+		"autoclass":                   x.Autoclass,
+		"cors":                        x.Cors,
+		"default_kms_key":             x.DefaultKmsKey,
+		"force_destroy":               x.ForceDestroy,
+		"labels":                      x.Labels,
+		"lifecycle_rules":             x.LifecycleRules,
+		"public_access_prevention":    x.PublicAccessPrevention,
+		"requester_pays":              x.RequesterPays,
+		"retention_policy":            x.RetentionPolicy,
+		"soft_delete_retention":       x.SoftDeleteRetention,
+		"storage_class":               x.StorageClass,
+		"uniform_bucket_level_access": x.UniformBucketLevelAccess,
+		"versioning":                  x.Versioning,
+		"website":                     x.Website,
+	}
+}
+
+type Cors struct {
+	MaxAge          any
+	Methods         any
+	Origins         any
+	ResponseHeaders any
+}
+
+func (x Cors) ToExpr() any {
+	return map[string]any{
+		"max_age":          x.MaxAge,
+		"methods":          x.Methods,
+		"origins":          x.Origins,
+		"response_headers": x.ResponseHeaders,
 	}
 }
 
@@ -35,3 +77,59 @@ func (x Identifier) ToExpr() any {
 		},
 	}
 }
+
+type LifecycleCondition struct {
+	Age              any
+	CreatedBefore    any
+	MatchesPrefix    any
+	MatchesSuffix    any
+	NumNewerVersions any
+	WithState        any
+}
+
+func (x LifecycleCondition) ToExpr() any {
+	return map[string]any{
+		"age":                x.Age,
+		"created_before":     x.CreatedBefore,
+		"matches_prefix":     x.MatchesPrefix,
+		"matches_suffix":     x.MatchesSuffix,
+		"num_newer_versions": x.NumNewerVersions,
+		"with_state":         x.WithState,
+	}
+}
+
+type LifecycleRule struct {
+	Action       any
+	Condition    any
+	StorageClass any
+}
+
+func (x LifecycleRule) ToExpr() any {
+	return map[string]any{
+		"action":        x.Action,
+		"condition":     x.Condition,
+		"storage_class": x.StorageClass,
+	}
+}
+
+type RetentionPolicy struct {
+	RetentionPeriod any
+}
+
+func (x RetentionPolicy) ToExpr() any {
+	return map[string]any{
+		"retention_period": x.RetentionPeriod,
+	}
+}
+
+type Website struct {
+	MainPageSuffix any
+	NotFoundPage   any
+}
+
+func (x Website) ToExpr() any {
+	return map[string]any{
+		"main_page_suffix": x.MainPageSuffix,
+		"not_found_page":   x.NotFoundPage,
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package cloud_run_job
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	Container      any
+	Labels         any
+	MaxRetries     any
+	Parallelism    any
+	ServiceAccount any
+	TaskCount      any
+	Timeout        any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"container":       x.Container,
+		"labels":          x.Labels,
+		"max_retries":     x.MaxRetries,
+		"parallelism":     x.Parallelism,
+		"service_account": x.ServiceAccount,
+		"task_count":      x.TaskCount,
+		"timeout":         x.Timeout,
+	}
+}
+
+type Container struct {
+	Args    any
+	Command any
+	Cpu     any
+	Env     any
+	Image   any
+	Memory  any
+}
+
+func (x Container) ToExpr() any {
+	return map[string]any{
+		"args":    x.Args,
+		"command": x.Command,
+		"cpu":     x.Cpu,
+		"env":     x.Env,
+		"image":   x.Image,
+		"memory":  x.Memory,
+	}
+}
+
+type Identifier struct {
+	Alias    string
+	Location any
+	Name     any
+	Project  any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "cloud_run_job",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"location": x.Location,
+			"name":     x.Name,
+			"project":  x.Project,
+		},
+	}
+}
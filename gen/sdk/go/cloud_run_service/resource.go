@@ -0,0 +1,77 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package cloud_run_service
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	Container                     any
+	Description                   any
+	Ingress                       any
+	Labels                        any
+	MaxInstanceRequestConcurrency any
+	Scaling                       any
+	ServiceAccount                any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"container":                        x.Container,
+		"description":                      x.Description,
+		"ingress":                          x.Ingress,
+		"labels":                           x.Labels,
+		"max_instance_request_concurrency": x.MaxInstanceRequestConcurrency,
+		"scaling":                          x.Scaling,
+		"service_account":                  x.ServiceAccount,
+	}
+}
+
+type Container struct {
+	Cpu    any
+	Env    any
+	Image  any
+	Memory any
+}
+
+func (x Container) ToExpr() any {
+	return map[string]any{
+		"cpu":    x.Cpu,
+		"env":    x.Env,
+		"image":  x.Image,
+		"memory": x.Memory,
+	}
+}
+
+type Identifier struct {
+	Alias    string
+	Location any
+	Name     any
+	Project  any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "cloud_run_service",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"location": x.Location,
+			"name":     x.Name,
+			"project":  x.Project,
+		},
+	}
+}
+
+type Scaling struct {
+	MaxInstanceCount any
+	MinInstanceCount any
+}
+
+func (x Scaling) ToExpr() any {
+	return map[string]any{
+		"max_instance_count": x.MaxInstanceCount,
+		"min_instance_count": x.MinInstanceCount,
+	}
+}
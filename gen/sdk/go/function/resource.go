@@ -22,16 +22,54 @@ func (x BuildConfig) ToExpr() any {
 }
 
 type Config struct {
-	BuildConfig any
-	Description any
-	Labels      any
+	BuildConfig   any
+	Description   any
+	EventTrigger  any
+	Labels        any
+	ServiceConfig any
 }
 
 func (x Config) ToExpr() any {
 	return map[string]any{
-		"build_config": x.BuildConfig,
-		"description":  x.Description,
-		"labels":       x.Labels,
+		"build_config":   x.BuildConfig,
+		"description":    x.Description,
+		"event_trigger":  x.EventTrigger,
+		"labels":         x.Labels,
+		"service_config": x.ServiceConfig,
+	}
+}
+
+type EventFilter struct {
+	Attribute any
+	Operator  any
+	Value     any
+}
+
+func (x EventFilter) ToExpr() any {
+	return map[string]any{
+		"attribute": x.Attribute,
+		"operator":  x.Operator,
+		"value":     x.Value,
+	}
+}
+
+type EventTrigger struct {
+	EventFilters   any
+	EventType      any
+	PubsubTopic    any
+	RetryPolicy    any
+	ServiceAccount any
+	TriggerRegion  any
+}
+
+func (x EventTrigger) ToExpr() any {
+	return map[string]any{
+		"event_filters":   x.EventFilters,
+		"event_type":      x.EventType,
+		"pubsub_topic":    x.PubsubTopic,
+		"retry_policy":    x.RetryPolicy,
+		"service_account": x.ServiceAccount,
+		"trigger_region":  x.TriggerRegion,
 	}
 }
 
@@ -53,3 +91,63 @@ func (x Identifier) ToExpr() any {
 		},
 	}
 }
+
+type SecretEnvironmentVariable struct {
+	Key     any
+	Secret  any
+	Version any
+}
+
+func (x SecretEnvironmentVariable) ToExpr() any {
+	return map[string]any{
+		"key":     x.Key,
+		"secret":  x.Secret,
+		"version": x.Version,
+	}
+}
+
+type SecretVolume struct {
+	MountPath any
+	Path      any
+	Secret    any
+	Version   any
+}
+
+func (x SecretVolume) ToExpr() any {
+	return map[string]any{
+		"mount_path": x.MountPath,
+		"path":       x.Path,
+		"secret":     x.Secret,
+		"version":    x.Version,
+	}
+}
+
+type ServiceConfig struct {
+	AvailableMemory               any
+	EnvironmentVariables          any
+	IngressSettings               any
+	MaxInstanceCount              any
+	MaxInstanceRequestConcurrency any
+	MinInstanceCount              any
+	SecretEnvironmentVariables    any
+	SecretVolumes                 any
+	ServiceAccount                any
+	TimeoutSeconds                any
+	VpcConnector                  any
+}
+
+func (x ServiceConfig) ToExpr() any {
+	return map[string]any{
+		"available_memory":                 x.AvailableMemory,
+		"environment_variables":            x.EnvironmentVariables,
+		"ingress_settings":                 x.IngressSettings,
+		"max_instance_count":               x.MaxInstanceCount,
+		"max_instance_request_concurrency": x.MaxInstanceRequestConcurrency,
+		"min_instance_count":               x.MinInstanceCount,
+		"secret_environment_variables":     x.SecretEnvironmentVariables,
+		"secret_volumes":                   x.SecretVolumes,
+		"service_account":                  x.ServiceAccount,
+		"timeout_seconds":                  x.TimeoutSeconds,
+		"vpc_connector":                    x.VpcConnector,
+	}
+}
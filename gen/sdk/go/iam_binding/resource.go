@@ -0,0 +1,41 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_binding
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	Members any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"members": x.Members,
+	}
+}
+
+type Identifier struct {
+	Alias                string
+	ConditionDescription any
+	ConditionExpression  any
+	ConditionTitle       any
+	Resource             any
+	Role                 any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "iam_binding",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"condition_description": x.ConditionDescription,
+			"condition_expression":  x.ConditionExpression,
+			"condition_title":       x.ConditionTitle,
+			"resource":              x.Resource,
+			"role":                  x.Role,
+		},
+	}
+}
@@ -0,0 +1,40 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{}
+}
+
+type Identifier struct {
+	Alias                string
+	ConditionDescription any
+	ConditionExpression  any
+	ConditionTitle       any
+	Member               any
+	Resource             any
+	Role                 any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "iam_member",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"condition_description": x.ConditionDescription,
+			"condition_expression":  x.ConditionExpression,
+			"condition_title":       x.ConditionTitle,
+			"member":                x.Member,
+			"resource":              x.Resource,
+			"role":                  x.Role,
+		},
+	}
+}
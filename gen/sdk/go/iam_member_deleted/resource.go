@@ -0,0 +1,32 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_deleted
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{}
+}
+
+type Identifier struct {
+	Alias  string
+	Member any
+	Uid    any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "iam_member_deleted",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"member": x.Member,
+			"uid":    x.Uid,
+		},
+	}
+}
@@ -0,0 +1,30 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_group
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{}
+}
+
+type Identifier struct {
+	Alias string
+	Email any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "iam_member_group",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"email": x.Email,
+		},
+	}
+}
@@ -0,0 +1,32 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_member_project_role
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{}
+}
+
+type Identifier struct {
+	Alias   string
+	Project any
+	Role    any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "iam_member_project_role",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"project": x.Project,
+			"role":    x.Role,
+		},
+	}
+}
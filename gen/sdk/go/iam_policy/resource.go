@@ -8,14 +8,30 @@ import (
 )
 
 type Binding struct {
-	Members any
-	Role    any
+	Condition any
+	Members   any
+	Role      any
 }
 
 func (x Binding) ToExpr() any {
 	return map[string]any{
-		"members": x.Members,
-		"role":    x.Role,
+		"condition": x.Condition,
+		"members":   x.Members,
+		"role":      x.Role,
+	}
+}
+
+type Condition struct {
+	Description any
+	Expression  any
+	Title       any
+}
+
+func (x Condition) ToExpr() any {
+	return map[string]any{
+		"description": x.Description,
+		"expression":  x.Expression,
+		"title":       x.Title,
 	}
 }
 
@@ -0,0 +1,34 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package iam_role_query
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{}
+}
+
+type Identifier struct {
+	Alias       string
+	Permissions any
+	Resource    any
+	Title       any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "iam_role_query",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"permissions": x.Permissions,
+			"resource":    x.Resource,
+			"title":       x.Title,
+		},
+	}
+}
@@ -0,0 +1,36 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package kms_crypto_key
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{}
+}
+
+type Identifier struct {
+	Alias    string
+	KeyRing  any
+	Location any
+	Name     any
+	Project  any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "kms_crypto_key",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"key_ring": x.KeyRing,
+			"location": x.Location,
+			"name":     x.Name,
+			"project":  x.Project,
+		},
+	}
+}
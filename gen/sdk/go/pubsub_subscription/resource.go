@@ -0,0 +1,71 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package pubsub_subscription
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	AckDeadlineSeconds        any
+	DeadLetterPolicy          any
+	EnableExactlyOnceDelivery any
+	Filter                    any
+	Labels                    any
+	PushEndpoint              any
+	RetryPolicy               any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"ack_deadline_seconds":         x.AckDeadlineSeconds,
+		"dead_letter_policy":           x.DeadLetterPolicy,
+		"enable_exactly_once_delivery": x.EnableExactlyOnceDelivery,
+		"filter":                       x.Filter,
+		"labels":                       x.Labels,
+		"push_endpoint":                x.PushEndpoint,
+		"retry_policy":                 x.RetryPolicy,
+	}
+}
+
+type DeadLetterPolicy struct {
+	DeadLetterTopic     any
+	MaxDeliveryAttempts any
+}
+
+func (x DeadLetterPolicy) ToExpr() any {
+	return map[string]any{
+		"dead_letter_topic":     x.DeadLetterTopic,
+		"max_delivery_attempts": x.MaxDeliveryAttempts,
+	}
+}
+
+type Identifier struct {
+	Alias string
+	Name  any
+	Topic any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "pubsub_subscription",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"name":  x.Name,
+			"topic": x.Topic,
+		},
+	}
+}
+
+type RetryPolicy struct {
+	MaximumBackoff any
+	MinimumBackoff any
+}
+
+func (x RetryPolicy) ToExpr() any {
+	return map[string]any{
+		"maximum_backoff": x.MaximumBackoff,
+		"minimum_backoff": x.MinimumBackoff,
+	}
+}
@@ -0,0 +1,53 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package pubsub_topic
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	KmsKeyName               any
+	Labels                   any
+	MessageRetentionDuration any
+	SchemaSettings           any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"kms_key_name":               x.KmsKeyName,
+		"labels":                     x.Labels,
+		"message_retention_duration": x.MessageRetentionDuration,
+		"schema_settings":            x.SchemaSettings,
+	}
+}
+
+type Identifier struct {
+	Alias   string
+	Name    any
+	Project any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "pubsub_topic",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"name":    x.Name,
+			"project": x.Project,
+		},
+	}
+}
+
+type SchemaSettings struct {
+	Encoding any
+	Schema   any
+}
+
+func (x SchemaSettings) ToExpr() any {
+	return map[string]any{
+		"encoding": x.Encoding,
+		"schema":   x.Schema,
+	}
+}
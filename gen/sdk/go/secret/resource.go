@@ -0,0 +1,45 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package secret
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	Annotations          any
+	Labels               any
+	ReplicationLocations any
+	RotationPeriod       any
+	Topics               any
+	Ttl                  any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"annotations":           x.Annotations,
+		"labels":                x.Labels,
+		"replication_locations": x.ReplicationLocations,
+		"rotation_period":       x.RotationPeriod,
+		"topics":                x.Topics,
+		"ttl":                   x.Ttl,
+	}
+}
+
+type Identifier struct {
+	Alias   string
+	Name    any
+	Project any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "secret",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"name":    x.Name,
+			"project": x.Project,
+		},
+	}
+}
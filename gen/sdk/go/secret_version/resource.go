@@ -0,0 +1,37 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package secret_version
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	Contents        any
+	DestroyOnDelete any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"contents":          x.Contents,
+		"destroy_on_delete": x.DestroyOnDelete,
+	}
+}
+
+type Identifier struct {
+	Alias  string
+	Name   any
+	Secret any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "secret_version",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"name":   x.Name,
+			"secret": x.Secret,
+		},
+	}
+}
@@ -9,12 +9,14 @@ import (
 
 type Config struct {
 	Description any
+	Disabled    any
 	DisplayName any
 }
 
 func (x Config) ToExpr() any {
 	return map[string]any{
 		"description":  x.Description,
+		"disabled":     x.Disabled,
 		"display_name": x.DisplayName,
 	}
 }
@@ -0,0 +1,39 @@
+// Code generated by athanor-go.
+// DO NOT EDIT.
+
+package service_account_key
+
+import (
+	sdk "github.com/alchematik/athanor-go/sdk/consumer"
+)
+
+type Config struct {
+	Keepers       any
+	KeyAlgorithm  any
+	PublicKeyFile any
+}
+
+func (x Config) ToExpr() any {
+	return map[string]any{
+		"keepers":         x.Keepers,
+		"key_algorithm":   x.KeyAlgorithm,
+		"public_key_file": x.PublicKeyFile,
+	}
+}
+
+type Identifier struct {
+	Alias          string
+	Name           any
+	ServiceAccount any
+}
+
+func (x Identifier) ToExpr() any {
+	return sdk.ResourceIdentifier{
+		ResourceType: "service_account_key",
+		Alias:        x.Alias,
+		Value: map[string]any{
+			"name":            x.Name,
+			"service_account": x.ServiceAccount,
+		},
+	}
+}
@@ -4,41 +4,85 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/alchematik/athanor-provider-gcp/gen/provider/bucket"
 	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
 
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"cloud.google.com/go/resourcemanager/apiv3/resourcemanagerpb"
 	"cloud.google.com/go/storage"
 	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
 	value "github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
 )
 
+var (
+	kmsKeyRe = regexp.MustCompile(`projects\/(.+)\/locations\/(.+)\/keyRings\/(.+)\/cryptoKeys\/(.+)`)
+)
+
+// forceDestroyLabel carries force_destroy to the deleter, which only receives the identifier.
+const forceDestroyLabel = "athanor-force-destroy"
+
+var (
+	retentionPeriodField     = fieldcodec.Duration{Name: "retention_policy.retention_period"}
+	softDeleteRetentionField = fieldcodec.Duration{Name: "soft_delete_retention", Default: 7 * 24 * time.Hour}
+	// GCS reports STANDARD when no storage class is set.
+	storageClassField = fieldcodec.String{Name: "storage_class", Default: "STANDARD"}
+)
+
+func maxAgeField(i int) fieldcodec.Duration {
+	return fieldcodec.Duration{Name: fmt.Sprintf("cors[%d].max_age", i)}
+}
+
 func NewHandler(ctx context.Context) (*bucket.BucketHandler, error) {
 	gcp, err := storage.NewClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error creating GCP storage client: %v", err)
 	}
 
+	projects, err := resourcemanager.NewProjectsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &client{
-		Storage: gcp,
+		Storage:  gcp,
+		Projects: projects,
 	}
 	return &bucket.BucketHandler{
 		BucketGetter:  c,
 		BucketCreator: c,
 		BucketUpdator: c,
 		BucketDeleter: c,
-		CloseFunc:     gcp.Close,
+		CloseFunc: func() error {
+			return errors.Join(gcp.Close(), projects.Close())
+		},
 	}, nil
 }
 
 type client struct {
-	Storage Storage
+	Storage  Storage
+	Projects Projects
+
+	// Project numbers by project ID, since buckets only report the number.
+	mu             sync.Mutex
+	projectNumbers map[string]string
 }
 
 type Storage interface {
 	Bucket(string) *storage.BucketHandle
 }
 
+type Projects interface {
+	GetProject(context.Context, *resourcemanagerpb.GetProjectRequest, ...gax.CallOption) (*resourcemanagerpb.Project, error)
+}
+
 func (c *client) GetBucket(ctx context.Context, id identifier.BucketIdentifier) (bucket.Bucket, error) {
 	b := c.Storage.Bucket(id.Name)
 
@@ -51,23 +95,79 @@ func (c *client) GetBucket(ctx context.Context, id identifier.BucketIdentifier)
 		return bucket.Bucket{}, fmt.Errorf("oh no: %v", err)
 	}
 
-	return bucket.Bucket{
-		Identifier: id,
-		Config: bucket.Config{
-			Labels: attrs.Labels,
-		},
-		Attrs: bucket.Attrs{
-			Create: attrs.Created.String(),
-			Etag:   fmt.Sprintf("%x", attrs.Etag),
-		},
-	}, nil
+	if err := c.checkPlacement(ctx, id, attrs); err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	return toBucket(id, attrs)
 }
 
 func (c *client) CreateBucket(ctx context.Context, id identifier.BucketIdentifier, config bucket.Config) (bucket.Bucket, error) {
+	lifecycle, err := toLifecycle(config.LifecycleRules)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	retentionPolicy, err := toRetentionPolicy(config.RetentionPolicy)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	publicAccessPrevention, err := toPublicAccessPrevention(config.PublicAccessPrevention)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	encryption, err := toEncryption(config.DefaultKmsKey)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	softDeletePolicy, err := toSoftDeletePolicy(config.SoftDeleteRetention)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	cors, err := toCORS(config.Cors)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	storageClass, err := storageClassField.Parse(config.StorageClass)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	labels, err := toLabels(config.Labels, config.ForceDestroy)
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	var autoclass *storage.Autoclass
+	if config.Autoclass {
+		autoclass = &storage.Autoclass{
+			Enabled: true,
+		}
+	}
+
 	b := c.Storage.Bucket(id.Name)
 	if err := b.Create(ctx, id.Project, &storage.BucketAttrs{
-		Labels:   config.Labels,
-		Location: id.Location,
+		Labels:            labels,
+		Location:          id.Location,
+		VersioningEnabled: config.Versioning,
+		Lifecycle:         lifecycle,
+		RetentionPolicy:   retentionPolicy,
+		UniformBucketLevelAccess: storage.UniformBucketLevelAccess{
+			Enabled: config.UniformBucketLevelAccess,
+		},
+		PublicAccessPrevention: publicAccessPrevention,
+		Encryption:             encryption,
+		StorageClass:           storageClass,
+		CORS:                   cors,
+		Website:                toWebsite(config.Website),
+		Autoclass:              autoclass,
+		RequesterPays:          config.RequesterPays,
+		SoftDeletePolicy:       softDeletePolicy,
 	}); err != nil {
 		return bucket.Bucket{}, err
 	}
@@ -77,16 +177,7 @@ func (c *client) CreateBucket(ctx context.Context, id identifier.BucketIdentifie
 		return bucket.Bucket{}, err
 	}
 
-	return bucket.Bucket{
-		Identifier: id,
-		Config: bucket.Config{
-			Labels: attrs.Labels,
-		},
-		Attrs: bucket.Attrs{
-			Create: attrs.Created.String(),
-			Etag:   fmt.Sprintf("%x", attrs.Etag),
-		},
-	}, nil
+	return toBucket(id, attrs)
 }
 
 func (c *client) UpdateBucket(ctx context.Context, id identifier.BucketIdentifier, config bucket.Config, mask []value.UpdateMaskField) (bucket.Bucket, error) {
@@ -95,6 +186,10 @@ func (c *client) UpdateBucket(ctx context.Context, id identifier.BucketIdentifie
 		switch m.Name {
 		case "labels":
 			for _, label := range m.SubFields {
+				if label.Name == forceDestroyLabel {
+					return bucket.Bucket{}, fmt.Errorf("label %q is reserved for force_destroy", forceDestroyLabel)
+				}
+
 				if label.Operation == value.OperationDelete {
 					toUpdate.DeleteLabel(label.Name)
 				} else {
@@ -106,6 +201,90 @@ func (c *client) UpdateBucket(ctx context.Context, id identifier.BucketIdentifie
 					toUpdate.SetLabel(label.Name, val)
 				}
 			}
+		case "versioning":
+			toUpdate.VersioningEnabled = config.Versioning
+		case "force_destroy":
+			if config.ForceDestroy {
+				toUpdate.SetLabel(forceDestroyLabel, "true")
+			} else {
+				toUpdate.DeleteLabel(forceDestroyLabel)
+			}
+		case "lifecycle_rules":
+			lifecycle, err := toLifecycle(config.LifecycleRules)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+			toUpdate.Lifecycle = &lifecycle
+		case "retention_policy":
+			retentionPolicy, err := toRetentionPolicy(config.RetentionPolicy)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+
+			// A zero retention period removes the policy.
+			if retentionPolicy == nil {
+				retentionPolicy = &storage.RetentionPolicy{}
+			}
+			toUpdate.RetentionPolicy = retentionPolicy
+		case "uniform_bucket_level_access":
+			toUpdate.UniformBucketLevelAccess = &storage.UniformBucketLevelAccess{
+				Enabled: config.UniformBucketLevelAccess,
+			}
+		case "public_access_prevention":
+			publicAccessPrevention, err := toPublicAccessPrevention(config.PublicAccessPrevention)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+			toUpdate.PublicAccessPrevention = publicAccessPrevention
+		case "default_kms_key":
+			encryption, err := toEncryption(config.DefaultKmsKey)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+
+			// An empty key name removes the default key.
+			if encryption == nil {
+				encryption = &storage.BucketEncryption{}
+			}
+			toUpdate.Encryption = encryption
+		case "soft_delete_retention":
+			softDeletePolicy, err := toSoftDeletePolicy(config.SoftDeleteRetention)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+
+			// Emptying the field restores the default policy.
+			if softDeletePolicy == nil {
+				softDeletePolicy = &storage.SoftDeletePolicy{
+					RetentionDuration: softDeleteRetentionField.Default,
+				}
+			}
+			toUpdate.SoftDeletePolicy = softDeletePolicy
+		case "storage_class":
+			storageClass, err := storageClassField.Parse(config.StorageClass)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+			toUpdate.StorageClass = storageClass
+		case "cors":
+			cors, err := toCORS(config.Cors)
+			if err != nil {
+				return bucket.Bucket{}, err
+			}
+			toUpdate.CORS = cors
+		case "website":
+			// An empty website removes the configuration.
+			website := toWebsite(config.Website)
+			if website == nil {
+				website = &storage.BucketWebsite{}
+			}
+			toUpdate.Website = website
+		case "autoclass":
+			toUpdate.Autoclass = &storage.Autoclass{
+				Enabled: config.Autoclass,
+			}
+		case "requester_pays":
+			toUpdate.RequesterPays = config.RequesterPays
 		}
 	}
 
@@ -115,10 +294,405 @@ func (c *client) UpdateBucket(ctx context.Context, id identifier.BucketIdentifie
 		return bucket.Bucket{}, err
 	}
 
+	return toBucket(id, attrs)
+}
+
+func (c *client) DeleteBucket(ctx context.Context, id identifier.BucketIdentifier) error {
+	b := c.Storage.Bucket(id.Name)
+
+	// Never delete a bucket that only shares the name.
+	attrs, err := b.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := c.checkPlacement(ctx, id, attrs); err != nil {
+		return err
+	}
+
+	if attrs.Labels[forceDestroyLabel] == "true" {
+		if err := deleteObjects(ctx, b); err != nil {
+			return err
+		}
+	} else {
+		// GCS only reports that the bucket isn't empty, so the objects are counted to say how many are left.
+		count, err := countObjects(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return fmt.Errorf("bucket %s still contains %d objects, including noncurrent versions: set force_destroy and apply it before removing the bucket to delete them along with it", id.Name, count)
+		}
+	}
+
+	return b.Delete(ctx)
+}
+
+// checkPlacement returns a ConflictError if the bucket isn't in the identifier's location and project.
+func (c *client) checkPlacement(ctx context.Context, id identifier.BucketIdentifier, attrs *storage.BucketAttrs) error {
+	// GCS reports locations in upper case.
+	if !strings.EqualFold(attrs.Location, id.Location) {
+		return &ConflictError{
+			Name:     id.Name,
+			Field:    "location",
+			Expected: id.Location,
+			Actual:   strings.ToLower(attrs.Location),
+		}
+	}
+
+	projectNumber, err := c.projectNumber(ctx, id.Project)
+	if err != nil {
+		return err
+	}
+
+	actual := strconv.FormatUint(attrs.ProjectNumber, 10)
+	if actual != projectNumber {
+		return &ConflictError{
+			Name:     id.Name,
+			Field:    "project",
+			Expected: id.Project,
+			Actual:   actual,
+		}
+	}
+
+	return nil
+}
+
+func (c *client) projectNumber(ctx context.Context, project string) (string, error) {
+	if _, err := strconv.ParseUint(project, 10, 64); err == nil {
+		return project, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if n, ok := c.projectNumbers[project]; ok {
+		return n, nil
+	}
+
+	res, err := c.Projects.GetProject(ctx, &resourcemanagerpb.GetProjectRequest{
+		Name: fmt.Sprintf("projects/%s", project),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting project %s: %v", project, err)
+	}
+
+	if c.projectNumbers == nil {
+		c.projectNumbers = map[string]string{}
+	}
+
+	// Has the form of projects/<project number>
+	n := strings.TrimPrefix(res.GetName(), "projects/")
+	c.projectNumbers[project] = n
+
+	return n, nil
+}
+
+// Lifecycle conditions are dates without a time, in UTC.
+const conditionDateFormat = "2006-01-02"
+
+func toLifecycle(rules []bucket.LifecycleRule) (storage.Lifecycle, error) {
+	var lifecycle storage.Lifecycle
+	for i, r := range rules {
+		condition := storage.LifecycleCondition{
+			MatchesPrefix: r.Condition.MatchesPrefix,
+			MatchesSuffix: r.Condition.MatchesSuffix,
+		}
+
+		// An age of 0 has to be set through AllObjects, since the client drops zero values.
+		if r.Condition.Age != "" {
+			age, err := parseInt(fmt.Sprintf("lifecycle_rules[%d].condition.age", i), r.Condition.Age)
+			if err != nil {
+				return storage.Lifecycle{}, err
+			}
+
+			condition.AgeInDays = age
+			condition.AllObjects = age == 0
+		}
+
+		if r.Condition.NumNewerVersions != "" {
+			num, err := parseInt(fmt.Sprintf("lifecycle_rules[%d].condition.num_newer_versions", i), r.Condition.NumNewerVersions)
+			if err != nil {
+				return storage.Lifecycle{}, err
+			}
+
+			condition.NumNewerVersions = num
+		}
+
+		if r.Condition.CreatedBefore != "" {
+			createdBefore, err := time.Parse(conditionDateFormat, r.Condition.CreatedBefore)
+			if err != nil {
+				return storage.Lifecycle{}, fmt.Errorf("invalid value for lifecycle_rules[%d].condition.created_before: %q", i, r.Condition.CreatedBefore)
+			}
+
+			condition.CreatedBefore = createdBefore
+		}
+
+		switch r.Condition.WithState {
+		case "":
+			condition.Liveness = storage.LiveAndArchived
+		case "LIVE":
+			condition.Liveness = storage.Live
+		case "ARCHIVED":
+			condition.Liveness = storage.Archived
+		default:
+			return storage.Lifecycle{}, fmt.Errorf("invalid value for lifecycle_rules[%d].condition.with_state: %q", i, r.Condition.WithState)
+		}
+
+		lifecycle.Rules = append(lifecycle.Rules, storage.LifecycleRule{
+			Action: storage.LifecycleAction{
+				Type:         r.Action,
+				StorageClass: r.StorageClass,
+			},
+			Condition: condition,
+		})
+	}
+
+	return lifecycle, nil
+}
+
+func toRetentionPolicy(policy bucket.RetentionPolicy) (*storage.RetentionPolicy, error) {
+	d, err := retentionPeriodField.Parse(policy.RetentionPeriod)
+	if err != nil || d == 0 {
+		return nil, err
+	}
+
+	return &storage.RetentionPolicy{
+		RetentionPeriod: d,
+	}, nil
+}
+
+// toLabels adds the force_destroy label to the configured labels.
+func toLabels(config map[string]string, forceDestroy bool) (map[string]string, error) {
+	labels := map[string]string{}
+	for k, v := range config {
+		if k == forceDestroyLabel {
+			return nil, fmt.Errorf("label %q is reserved for force_destroy", forceDestroyLabel)
+		}
+
+		labels[k] = v
+	}
+
+	if forceDestroy {
+		labels[forceDestroyLabel] = "true"
+	}
+
+	return labels, nil
+}
+
+func toPublicAccessPrevention(str string) (storage.PublicAccessPrevention, error) {
+	switch str {
+	case "":
+		return storage.PublicAccessPreventionInherited, nil
+	case "enforced":
+		return storage.PublicAccessPreventionEnforced, nil
+	default:
+		return 0, fmt.Errorf("invalid value for public_access_prevention: %q", str)
+	}
+}
+
+func toEncryption(keys []value.ResourceIdentifier) (*storage.BucketEncryption, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	if len(keys) > 1 {
+		return nil, fmt.Errorf("field default_kms_key must have at most one element")
+	}
+
+	keyID, ok := keys[0].(identifier.KmsCryptoKeyIdentifier)
+	if !ok {
+		return nil, fmt.Errorf("field default_kms_key must be a kms_crypto_key identifier")
+	}
+
+	return &storage.BucketEncryption{
+		DefaultKMSKeyName: fmt.Sprintf("projects/%s/locations/%s/keyRings/%s/cryptoKeys/%s", keyID.Project, keyID.Location, keyID.KeyRing, keyID.Name),
+	}, nil
+}
+
+// toSoftDeletePolicy returns nil when the field is empty, so that GCS applies its default policy. A zero duration
+// is how soft delete is disabled.
+func toSoftDeletePolicy(str string) (*storage.SoftDeletePolicy, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	d, err := softDeleteRetentionField.Parse(str)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.SoftDeletePolicy{
+		RetentionDuration: d,
+	}, nil
+}
+
+// toCORS never returns nil, since an empty list is how CORS is removed in an update.
+func toCORS(config []bucket.Cors) ([]storage.CORS, error) {
+	cors := make([]storage.CORS, len(config))
+	for i, c := range config {
+		maxAge, err := maxAgeField(i).Parse(c.MaxAge)
+		if err != nil {
+			return nil, err
+		}
+
+		cors[i] = storage.CORS{
+			Origins:         c.Origins,
+			Methods:         c.Methods,
+			ResponseHeaders: c.ResponseHeaders,
+			MaxAge:          maxAge,
+		}
+	}
+
+	return cors, nil
+}
+
+func toWebsite(website bucket.Website) *storage.BucketWebsite {
+	if website.MainPageSuffix == "" && website.NotFoundPage == "" {
+		return nil
+	}
+
+	return &storage.BucketWebsite{
+		MainPageSuffix: website.MainPageSuffix,
+		NotFoundPage:   website.NotFoundPage,
+	}
+}
+
+func parseInt(field, str string) (int64, error) {
+	i, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %q", field, str)
+	}
+
+	return i, nil
+}
+
+func toBucket(id identifier.BucketIdentifier, attrs *storage.BucketAttrs) (bucket.Bucket, error) {
+	var rules []bucket.LifecycleRule
+	for _, r := range attrs.Lifecycle.Rules {
+		var age string
+		if r.Condition.AllObjects || r.Condition.AgeInDays > 0 {
+			age = strconv.FormatInt(r.Condition.AgeInDays, 10)
+		}
+
+		var numNewerVersions string
+		if r.Condition.NumNewerVersions > 0 {
+			numNewerVersions = strconv.FormatInt(r.Condition.NumNewerVersions, 10)
+		}
+
+		var createdBefore string
+		if !r.Condition.CreatedBefore.IsZero() {
+			createdBefore = r.Condition.CreatedBefore.Format(conditionDateFormat)
+		}
+
+		var withState string
+		switch r.Condition.Liveness {
+		case storage.Live:
+			withState = "LIVE"
+		case storage.Archived:
+			withState = "ARCHIVED"
+		}
+
+		rules = append(rules, bucket.LifecycleRule{
+			Action:       r.Action.Type,
+			StorageClass: r.Action.StorageClass,
+			Condition: bucket.LifecycleCondition{
+				Age:              age,
+				CreatedBefore:    createdBefore,
+				NumNewerVersions: numNewerVersions,
+				MatchesPrefix:    r.Condition.MatchesPrefix,
+				MatchesSuffix:    r.Condition.MatchesSuffix,
+				WithState:        withState,
+			},
+		})
+	}
+
+	var retentionPolicy bucket.RetentionPolicy
+	if attrs.RetentionPolicy != nil {
+		retentionPolicy.RetentionPeriod = retentionPeriodField.Format(attrs.RetentionPolicy.RetentionPeriod)
+	}
+
+	var publicAccessPrevention string
+	if attrs.PublicAccessPrevention == storage.PublicAccessPreventionEnforced {
+		publicAccessPrevention = "enforced"
+	}
+
+	var defaultKmsKey []value.ResourceIdentifier
+	if attrs.Encryption != nil && attrs.Encryption.DefaultKMSKeyName != "" {
+		matches := kmsKeyRe.FindStringSubmatch(attrs.Encryption.DefaultKMSKeyName)
+		if len(matches) < 5 {
+			return bucket.Bucket{}, fmt.Errorf("invalid KMS key in response: %q", attrs.Encryption.DefaultKMSKeyName)
+		}
+
+		defaultKmsKey = []value.ResourceIdentifier{
+			identifier.KmsCryptoKeyIdentifier{
+				Project:  matches[1],
+				Location: matches[2],
+				KeyRing:  matches[3],
+				Name:     matches[4],
+			},
+		}
+	}
+
+	var softDeleteRetention string
+	if attrs.SoftDeletePolicy != nil {
+		softDeleteRetention = softDeleteRetentionField.Format(attrs.SoftDeletePolicy.RetentionDuration)
+
+		// Format reads zero back as empty, but here it means soft delete is disabled rather than the default.
+		if attrs.SoftDeletePolicy.RetentionDuration == 0 {
+			softDeleteRetention = "0s"
+		}
+	}
+
+	var cors []bucket.Cors
+	for i, c := range attrs.CORS {
+		cors = append(cors, bucket.Cors{
+			Origins:         c.Origins,
+			Methods:         c.Methods,
+			ResponseHeaders: c.ResponseHeaders,
+			MaxAge:          maxAgeField(i).Format(c.MaxAge),
+		})
+	}
+
+	var website bucket.Website
+	if attrs.Website != nil {
+		website = bucket.Website{
+			MainPageSuffix: attrs.Website.MainPageSuffix,
+			NotFoundPage:   attrs.Website.NotFoundPage,
+		}
+	}
+
+	// The force_destroy label isn't part of labels.
+	var labels map[string]string
+	for k, v := range attrs.Labels {
+		if k == forceDestroyLabel {
+			continue
+		}
+
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[k] = v
+	}
+
 	return bucket.Bucket{
 		Identifier: id,
 		Config: bucket.Config{
-			Labels: attrs.Labels,
+			Labels:                   labels,
+			Versioning:               attrs.VersioningEnabled,
+			LifecycleRules:           rules,
+			RetentionPolicy:          retentionPolicy,
+			UniformBucketLevelAccess: attrs.UniformBucketLevelAccess.Enabled,
+			PublicAccessPrevention:   publicAccessPrevention,
+			DefaultKmsKey:            defaultKmsKey,
+			SoftDeleteRetention:      softDeleteRetention,
+			StorageClass:             storageClassField.Format(attrs.StorageClass),
+			Cors:                     cors,
+			Website:                  website,
+			Autoclass:                attrs.Autoclass != nil && attrs.Autoclass.Enabled,
+			RequesterPays:            attrs.RequesterPays,
+			ForceDestroy:             attrs.Labels[forceDestroyLabel] == "true",
 		},
 		Attrs: bucket.Attrs{
 			Create: attrs.Created.String(),
@@ -126,8 +700,3 @@ func (c *client) UpdateBucket(ctx context.Context, id identifier.BucketIdentifie
 		},
 	}, nil
 }
-
-func (c *client) DeleteBucket(ctx context.Context, id identifier.BucketIdentifier) error {
-	b := c.Storage.Bucket(id.Name)
-	return b.Delete(ctx)
-}
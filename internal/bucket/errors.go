@@ -0,0 +1,19 @@
+package bucket
+
+import (
+	"fmt"
+)
+
+// ConflictError is returned when a bucket with the identifier's name exists, but not where the identifier says it
+// should be. Bucket names are global, so it's likely a different bucket that shouldn't be adopted.
+type ConflictError struct {
+	Name string
+	// Field is either "location" or "project".
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("bucket %s exists with %s %q, expected %q", e.Name, e.Field, e.Actual, e.Expected)
+}
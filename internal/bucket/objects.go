@@ -0,0 +1,70 @@
+package bucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/iterator"
+)
+
+// deleteWorkers bounds how many objects are deleted at once.
+const deleteWorkers = 16
+
+// countObjects counts every object in the bucket, including noncurrent versions, since they also keep the bucket
+// from being deleted.
+func countObjects(ctx context.Context, b *storage.BucketHandle) (int, error) {
+	it := b.Objects(ctx, &storage.Query{
+		Versions: true,
+	})
+
+	var count int
+	for {
+		_, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return count, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("error listing objects: %v", err)
+		}
+
+		count++
+	}
+}
+
+// deleteObjects deletes every object in the bucket, including noncurrent versions.
+func deleteObjects(ctx context.Context, b *storage.BucketHandle) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(deleteWorkers)
+
+	it := b.Objects(ctx, &storage.Query{
+		Versions: true,
+	})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			// Stop the workers and surface whichever error came first.
+			if waitErr := g.Wait(); waitErr != nil {
+				return waitErr
+			}
+
+			return fmt.Errorf("error listing objects: %v", err)
+		}
+
+		obj := b.Object(attrs.Name).Generation(attrs.Generation)
+		g.Go(func() error {
+			if err := obj.Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+				return fmt.Errorf("error deleting object %s#%d: %v", attrs.Name, attrs.Generation, err)
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
@@ -0,0 +1,232 @@
+package cloud_run_job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudrunjob "github.com/alchematik/athanor-provider-gcp/gen/provider/cloud_run_job"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+	"github.com/alchematik/athanor-provider-gcp/internal/iamcodec"
+	"github.com/alchematik/athanor-provider-gcp/internal/runcontainer"
+
+	cloudrun "cloud.google.com/go/run/apiv2"
+	"cloud.google.com/go/run/apiv2/runpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GCP reports these values back when the fields are left empty.
+var (
+	taskCountField   = fieldcodec.Int32{Name: "task_count", Default: 1}
+	parallelismField = fieldcodec.Int32{Name: "parallelism"}
+	maxRetriesField  = fieldcodec.Int32{Name: "max_retries", Default: 3}
+	timeoutField     = fieldcodec.Duration{Name: "timeout", Default: 10 * time.Minute}
+)
+
+func NewHandler(ctx context.Context) (*cloudrunjob.CloudRunJobHandler, error) {
+	gcp, err := cloudrun.NewJobsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &cloudrunjob.CloudRunJobHandler{
+		CloudRunJobGetter:  c,
+		CloudRunJobCreator: c,
+		CloudRunJobUpdator: c,
+		CloudRunJobDeleter: c,
+		CloseFunc:          gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetJob(context.Context, *runpb.GetJobRequest, ...gax.CallOption) (*runpb.Job, error)
+	CreateJob(context.Context, *runpb.CreateJobRequest, ...gax.CallOption) (*cloudrun.CreateJobOperation, error)
+	UpdateJob(context.Context, *runpb.UpdateJobRequest, ...gax.CallOption) (*cloudrun.UpdateJobOperation, error)
+	DeleteJob(context.Context, *runpb.DeleteJobRequest, ...gax.CallOption) (*cloudrun.DeleteJobOperation, error)
+}
+
+func (c *client) GetCloudRunJob(ctx context.Context, id identifier.CloudRunJobIdentifier) (cloudrunjob.CloudRunJob, error) {
+	res, err := c.GCP.GetJob(ctx, &runpb.GetJobRequest{
+		Name: fmt.Sprintf("projects/%s/locations/%s/jobs/%s", id.Project, id.Location, id.Name),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return cloudrunjob.CloudRunJob{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	return toCloudRunJob(id, res)
+}
+
+func (c *client) CreateCloudRunJob(ctx context.Context, id identifier.CloudRunJobIdentifier, config cloudrunjob.Config) (cloudrunjob.CloudRunJob, error) {
+	job, err := toJobPB(config)
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	operation, err := c.GCP.CreateJob(ctx, &runpb.CreateJobRequest{
+		Parent: fmt.Sprintf("projects/%s/locations/%s", id.Project, id.Location),
+		JobId:  id.Name,
+		Job:    job,
+	})
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	res, err := operation.Wait(ctx)
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	return toCloudRunJob(id, res)
+}
+
+func (c *client) UpdateCloudRunJob(ctx context.Context, id identifier.CloudRunJobIdentifier, config cloudrunjob.Config, mask []value.UpdateMaskField) (cloudrunjob.CloudRunJob, error) {
+	// The v2 API has no update mask, so the whole job template is replaced with the desired config.
+	job, err := toJobPB(config)
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+	job.Name = fmt.Sprintf("projects/%s/locations/%s/jobs/%s", id.Project, id.Location, id.Name)
+
+	operation, err := c.GCP.UpdateJob(ctx, &runpb.UpdateJobRequest{
+		Job: job,
+	})
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	res, err := operation.Wait(ctx)
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	return toCloudRunJob(id, res)
+}
+
+func (c *client) DeleteCloudRunJob(ctx context.Context, id identifier.CloudRunJobIdentifier) error {
+	operation, err := c.GCP.DeleteJob(ctx, &runpb.DeleteJobRequest{
+		Name: fmt.Sprintf("projects/%s/locations/%s/jobs/%s", id.Project, id.Location, id.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = operation.Wait(ctx)
+	return err
+}
+
+func toJobPB(config cloudrunjob.Config) (*runpb.Job, error) {
+	serviceAccount, err := iamcodec.FormatRuntimeServiceAccountEmail(config.ServiceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("field service_account: %w", err)
+	}
+
+	taskCount, err := taskCountField.Parse(config.TaskCount)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism, err := parallelismField.Parse(config.Parallelism)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retries is a oneof, so it's only set when max_retries is. Otherwise an explicit 0 would be sent.
+	var retries *runpb.TaskTemplate_MaxRetries
+	if config.MaxRetries != "" {
+		maxRetries, err := maxRetriesField.Parse(config.MaxRetries)
+		if err != nil {
+			return nil, err
+		}
+		retries = &runpb.TaskTemplate_MaxRetries{
+			MaxRetries: maxRetries,
+		}
+	}
+
+	timeout, err := timeoutField.ParseProto(config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := runcontainer.ToResourcesPB(config.Container.Cpu, config.Container.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runpb.Job{
+		Labels: config.Labels,
+		Template: &runpb.ExecutionTemplate{
+			TaskCount:   taskCount,
+			Parallelism: parallelism,
+			Template: &runpb.TaskTemplate{
+				ServiceAccount: serviceAccount,
+				Retries:        retries,
+				Timeout:        timeout,
+				Containers: []*runpb.Container{
+					{
+						Image:     config.Container.Image,
+						Command:   config.Container.Command,
+						Args:      config.Container.Args,
+						Env:       runcontainer.ToEnvPB(config.Container.Env),
+						Resources: resources,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func toCloudRunJob(id identifier.CloudRunJobIdentifier, res *runpb.Job) (cloudrunjob.CloudRunJob, error) {
+	taskTemplate := res.GetTemplate().GetTemplate()
+
+	// Jobs managed by this provider always have exactly one container.
+	var container *runpb.Container
+	if len(taskTemplate.GetContainers()) > 0 {
+		container = taskTemplate.GetContainers()[0]
+	}
+
+	serviceAccount, err := iamcodec.ParseRuntimeServiceAccountEmail(taskTemplate.GetServiceAccount())
+	if err != nil {
+		return cloudrunjob.CloudRunJob{}, err
+	}
+
+	cpu, memory := runcontainer.ToResources(container.GetResources())
+
+	return cloudrunjob.CloudRunJob{
+		Identifier: id,
+		Config: cloudrunjob.Config{
+			Labels:         res.GetLabels(),
+			ServiceAccount: serviceAccount,
+			TaskCount:      taskCountField.Format(res.GetTemplate().GetTaskCount()),
+			Parallelism:    parallelismField.Format(res.GetTemplate().GetParallelism()),
+			MaxRetries:     maxRetriesField.Format(taskTemplate.GetMaxRetries()),
+			Timeout:        timeoutField.Format(taskTemplate.GetTimeout().AsDuration()),
+			Container: cloudrunjob.Container{
+				Image:   container.GetImage(),
+				Command: container.GetCommand(),
+				Args:    container.GetArgs(),
+				Env:     runcontainer.ToEnv(container.GetEnv()),
+				Cpu:     cpu,
+				Memory:  memory,
+			},
+		},
+		Attrs: cloudrunjob.Attrs{
+			LatestCreatedExecution: res.GetLatestCreatedExecution().GetName(),
+		},
+	}, nil
+}
@@ -0,0 +1,230 @@
+package cloud_run_service
+
+import (
+	"context"
+	"fmt"
+
+	cloudrunservice "github.com/alchematik/athanor-provider-gcp/gen/provider/cloud_run_service"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+	"github.com/alchematik/athanor-provider-gcp/internal/iamcodec"
+	"github.com/alchematik/athanor-provider-gcp/internal/runcontainer"
+
+	cloudrun "cloud.google.com/go/run/apiv2"
+	"cloud.google.com/go/run/apiv2/runpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GCP reports these values back when the fields are left empty.
+var (
+	ingressField          = fieldcodec.String{Name: "ingress", Default: "INGRESS_TRAFFIC_ALL"}
+	concurrencyField      = fieldcodec.Int32{Name: "max_instance_request_concurrency", Default: 80}
+	minInstanceCountField = fieldcodec.Int32{Name: "scaling.min_instance_count"}
+	maxInstanceCountField = fieldcodec.Int32{Name: "scaling.max_instance_count", Default: 100}
+)
+
+func NewHandler(ctx context.Context) (*cloudrunservice.CloudRunServiceHandler, error) {
+	gcp, err := cloudrun.NewServicesClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &cloudrunservice.CloudRunServiceHandler{
+		CloudRunServiceGetter:  c,
+		CloudRunServiceCreator: c,
+		CloudRunServiceUpdator: c,
+		CloudRunServiceDeleter: c,
+		CloseFunc:              gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetService(context.Context, *runpb.GetServiceRequest, ...gax.CallOption) (*runpb.Service, error)
+	CreateService(context.Context, *runpb.CreateServiceRequest, ...gax.CallOption) (*cloudrun.CreateServiceOperation, error)
+	UpdateService(context.Context, *runpb.UpdateServiceRequest, ...gax.CallOption) (*cloudrun.UpdateServiceOperation, error)
+	DeleteService(context.Context, *runpb.DeleteServiceRequest, ...gax.CallOption) (*cloudrun.DeleteServiceOperation, error)
+}
+
+func (c *client) GetCloudRunService(ctx context.Context, id identifier.CloudRunServiceIdentifier) (cloudrunservice.CloudRunService, error) {
+	res, err := c.GCP.GetService(ctx, &runpb.GetServiceRequest{
+		Name: fmt.Sprintf("projects/%s/locations/%s/services/%s", id.Project, id.Location, id.Name),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return cloudrunservice.CloudRunService{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	return toCloudRunService(id, res)
+}
+
+func (c *client) CreateCloudRunService(ctx context.Context, id identifier.CloudRunServiceIdentifier, config cloudrunservice.Config) (cloudrunservice.CloudRunService, error) {
+	service, err := toServicePB(config)
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	operation, err := c.GCP.CreateService(ctx, &runpb.CreateServiceRequest{
+		Parent:    fmt.Sprintf("projects/%s/locations/%s", id.Project, id.Location),
+		ServiceId: id.Name,
+		Service:   service,
+	})
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	res, err := operation.Wait(ctx)
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	return toCloudRunService(id, res)
+}
+
+func (c *client) UpdateCloudRunService(ctx context.Context, id identifier.CloudRunServiceIdentifier, config cloudrunservice.Config, mask []value.UpdateMaskField) (cloudrunservice.CloudRunService, error) {
+	// The v2 API has no update mask, so the whole service is replaced with the desired config.
+	service, err := toServicePB(config)
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+	service.Name = fmt.Sprintf("projects/%s/locations/%s/services/%s", id.Project, id.Location, id.Name)
+
+	operation, err := c.GCP.UpdateService(ctx, &runpb.UpdateServiceRequest{
+		Service: service,
+	})
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	res, err := operation.Wait(ctx)
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	return toCloudRunService(id, res)
+}
+
+func (c *client) DeleteCloudRunService(ctx context.Context, id identifier.CloudRunServiceIdentifier) error {
+	operation, err := c.GCP.DeleteService(ctx, &runpb.DeleteServiceRequest{
+		Name: fmt.Sprintf("projects/%s/locations/%s/services/%s", id.Project, id.Location, id.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = operation.Wait(ctx)
+	return err
+}
+
+func toServicePB(config cloudrunservice.Config) (*runpb.Service, error) {
+	serviceAccount, err := iamcodec.FormatRuntimeServiceAccountEmail(config.ServiceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("field service_account: %w", err)
+	}
+
+	ingressName, err := ingressField.Parse(config.Ingress)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, ok := runpb.IngressTraffic_value[ingressName]
+	if !ok {
+		return nil, fmt.Errorf("invalid value for ingress: %q", config.Ingress)
+	}
+
+	concurrency, err := concurrencyField.Parse(config.MaxInstanceRequestConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	minInstances, err := minInstanceCountField.Parse(config.Scaling.MinInstanceCount)
+	if err != nil {
+		return nil, err
+	}
+
+	maxInstances, err := maxInstanceCountField.Parse(config.Scaling.MaxInstanceCount)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := runcontainer.ToResourcesPB(config.Container.Cpu, config.Container.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runpb.Service{
+		Description: config.Description,
+		Labels:      config.Labels,
+		Ingress:     runpb.IngressTraffic(ingress),
+		Template: &runpb.RevisionTemplate{
+			ServiceAccount:                serviceAccount,
+			MaxInstanceRequestConcurrency: concurrency,
+			Scaling: &runpb.RevisionScaling{
+				MinInstanceCount: minInstances,
+				MaxInstanceCount: maxInstances,
+			},
+			Containers: []*runpb.Container{
+				{
+					Image:     config.Container.Image,
+					Env:       runcontainer.ToEnvPB(config.Container.Env),
+					Resources: resources,
+				},
+			},
+		},
+	}, nil
+}
+
+func toCloudRunService(id identifier.CloudRunServiceIdentifier, res *runpb.Service) (cloudrunservice.CloudRunService, error) {
+	template := res.GetTemplate()
+
+	// Services managed by this provider always have exactly one container.
+	var container *runpb.Container
+	if len(template.GetContainers()) > 0 {
+		container = template.GetContainers()[0]
+	}
+
+	serviceAccount, err := iamcodec.ParseRuntimeServiceAccountEmail(template.GetServiceAccount())
+	if err != nil {
+		return cloudrunservice.CloudRunService{}, err
+	}
+
+	cpu, memory := runcontainer.ToResources(container.GetResources())
+
+	return cloudrunservice.CloudRunService{
+		Identifier: id,
+		Config: cloudrunservice.Config{
+			Description:    res.GetDescription(),
+			Labels:         res.GetLabels(),
+			Ingress:        ingressField.Format(res.GetIngress().String()),
+			ServiceAccount: serviceAccount,
+			Container: cloudrunservice.Container{
+				Image:  container.GetImage(),
+				Env:    runcontainer.ToEnv(container.GetEnv()),
+				Cpu:    cpu,
+				Memory: memory,
+			},
+			Scaling: cloudrunservice.Scaling{
+				MinInstanceCount: minInstanceCountField.Format(template.GetScaling().GetMinInstanceCount()),
+				MaxInstanceCount: maxInstanceCountField.Format(template.GetScaling().GetMaxInstanceCount()),
+			},
+			MaxInstanceRequestConcurrency: concurrencyField.Format(template.GetMaxInstanceRequestConcurrency()),
+		},
+		Attrs: cloudrunservice.Attrs{
+			Url:                 res.GetUri(),
+			LatestReadyRevision: res.GetLatestReadyRevision(),
+		},
+	}, nil
+}
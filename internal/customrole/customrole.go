@@ -0,0 +1,82 @@
+// Package customrole holds the logic shared by the project and organization custom role handlers.
+package customrole
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/iam/admin/apiv1/adminpb"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type GCP interface {
+	CreateRole(context.Context, *adminpb.CreateRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
+	GetRole(context.Context, *adminpb.GetRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
+	UpdateRole(context.Context, *adminpb.UpdateRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
+	UndeleteRole(context.Context, *adminpb.UndeleteRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
+}
+
+// Create creates a role under parent, which is either projects/<project> or organizations/<id>.
+//
+// GCP keeps deleted roles for 7 days and rejects creating a role with the same ID in the meantime, so a deleted
+// role is restored and brought up to date with the config instead.
+func Create(ctx context.Context, gcp GCP, parent, roleID string, role *adminpb.Role) (*adminpb.Role, error) {
+	name := fmt.Sprintf("%s/roles/%s", parent, roleID)
+
+	existing, err := gcp.GetRole(ctx, &adminpb.GetRoleRequest{
+		Name: name,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		return gcp.CreateRole(ctx, &adminpb.CreateRoleRequest{
+			Parent: parent,
+			RoleId: roleID,
+			Role:   role,
+		})
+	}
+
+	if !existing.GetDeleted() {
+		return nil, fmt.Errorf("role %s already exists", name)
+	}
+
+	if _, err := gcp.UndeleteRole(ctx, &adminpb.UndeleteRoleRequest{
+		Name: name,
+		Etag: existing.GetEtag(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return gcp.UpdateRole(ctx, &adminpb.UpdateRoleRequest{
+		Name: name,
+		Role: role,
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"title", "description", "included_permissions", "stage"},
+		},
+	})
+}
+
+// ConvertStage converts a launch stage config field.
+func ConvertStage(str string) (adminpb.Role_RoleLaunchStage, error) {
+	switch str {
+	case "ALPHA":
+		return adminpb.Role_ALPHA, nil
+	case "BETA":
+		return adminpb.Role_BETA, nil
+	case "GA":
+		return adminpb.Role_GA, nil
+	case "DEPRECATED":
+		return adminpb.Role_DEPRECATED, nil
+	case "DISABLED":
+		return adminpb.Role_DISABLED, nil
+	case "EAP":
+		return adminpb.Role_EAP, nil
+	default:
+		return 0, fmt.Errorf("invalid role launch stage: %s", str)
+	}
+}
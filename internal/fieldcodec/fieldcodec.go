@@ -0,0 +1,138 @@
+// Package fieldcodec converts string config fields to and from the values GCP APIs expect.
+//
+// GCP fills in a default for most fields that are left unset and reports it back on read. To keep an
+// empty config field from diffing against that default forever, each codec reads the default back as
+// an empty string and rejects it when it is set explicitly.
+package fieldcodec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var secondsRe = regexp.MustCompile(`^[0-9]+s$`)
+
+// Int32 is a numeric config field.
+type Int32 struct {
+	Name    string
+	Default int32
+}
+
+// Parse returns Default for an empty string.
+func (f Int32) Parse(str string) (int32, error) {
+	if str == "" {
+		return f.Default, nil
+	}
+
+	i, err := strconv.ParseInt(str, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %q", f.Name, str)
+	}
+
+	if int32(i) == f.Default {
+		return 0, &DefaultValueError{Field: f.Name, Value: str}
+	}
+
+	return int32(i), nil
+}
+
+// Format is the inverse of Parse.
+func (f Int32) Format(i int32) string {
+	if i == f.Default {
+		return ""
+	}
+
+	return strconv.FormatInt(int64(i), 10)
+}
+
+// String is a config field such as an enum or a resource quantity.
+type String struct {
+	Name    string
+	Default string
+}
+
+// Parse returns Default for an empty string.
+func (f String) Parse(str string) (string, error) {
+	if str == "" {
+		return f.Default, nil
+	}
+
+	if f.Default != "" && str == f.Default {
+		return "", &DefaultValueError{Field: f.Name, Value: str}
+	}
+
+	return str, nil
+}
+
+// Format is the inverse of Parse.
+func (f String) Format(str string) string {
+	if str == f.Default {
+		return ""
+	}
+
+	return str
+}
+
+// Duration is a config field holding a whole number of seconds such as "600s".
+//
+// GCP reports durations in seconds no matter how they were set, so other units are rejected rather
+// than read back in a different form.
+type Duration struct {
+	Name    string
+	Default time.Duration
+}
+
+// Parse returns Default for an empty string.
+func (f Duration) Parse(str string) (time.Duration, error) {
+	if str == "" {
+		return f.Default, nil
+	}
+
+	if !secondsRe.MatchString(str) {
+		return 0, fmt.Errorf("invalid value for %s: %q: must be a whole number of seconds such as \"600s\"", f.Name, str)
+	}
+
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %q", f.Name, str)
+	}
+
+	if d == f.Default {
+		return 0, &DefaultValueError{Field: f.Name, Value: str}
+	}
+
+	return d, nil
+}
+
+// ParseProto is like Parse, but returns nil when the duration is zero.
+func (f Duration) ParseProto(str string) (*durationpb.Duration, error) {
+	d, err := f.Parse(str)
+	if err != nil || d == 0 {
+		return nil, err
+	}
+
+	return durationpb.New(d), nil
+}
+
+// Format is the inverse of Parse. Zero is always formatted as an empty string.
+func (f Duration) Format(d time.Duration) string {
+	if d == f.Default || d == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%ds", int64(d.Round(time.Second)/time.Second))
+}
+
+// DefaultValueError is returned when a field is explicitly set to the value GCP uses when it is empty.
+type DefaultValueError struct {
+	Field string
+	Value string
+}
+
+func (e *DefaultValueError) Error() string {
+	return fmt.Sprintf("invalid value for %s: %q is the default, leave it empty instead", e.Field, e.Value)
+}
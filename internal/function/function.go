@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/alchematik/athanor-provider-gcp/gen/provider/function"
 	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+	"github.com/alchematik/athanor-provider-gcp/internal/iamcodec"
 
 	cloudfunction "cloud.google.com/go/functions/apiv2"
 	"cloud.google.com/go/functions/apiv2/functionspb"
@@ -20,6 +23,25 @@ import (
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// pubsubEventType is the only event type that takes a pubsub_topic.
+const pubsubEventType = "google.cloud.pubsub.topic.v1.messagePublished"
+
+// GCP reports these values back when the fields are left empty.
+var (
+	availableMemoryField  = fieldcodec.String{Name: "service_config.available_memory", Default: "256M"}
+	timeoutSecondsField   = fieldcodec.Int32{Name: "service_config.timeout_seconds", Default: 60}
+	minInstanceCountField = fieldcodec.Int32{Name: "service_config.min_instance_count"}
+	maxInstanceCountField = fieldcodec.Int32{Name: "service_config.max_instance_count", Default: 100}
+	concurrencyField      = fieldcodec.Int32{Name: "service_config.max_instance_request_concurrency", Default: 1}
+	ingressSettingsField  = fieldcodec.String{Name: "service_config.ingress_settings", Default: "ALLOW_ALL"}
+	retryPolicyField      = fieldcodec.String{Name: "event_trigger.retry_policy", Default: "RETRY_POLICY_DO_NOT_RETRY"}
+)
+
+// GCP sets the trigger region to the region of the function unless set.
+func triggerRegionField(location string) fieldcodec.String {
+	return fieldcodec.String{Name: "event_trigger.trigger_region", Default: location}
+}
+
 func NewHandler(ctx context.Context) (*function.FunctionHandler, error) {
 	gcp, err := cloudfunction.NewFunctionClient(ctx)
 	if err != nil {
@@ -89,6 +111,16 @@ func (c *client) GetFunction(ctx context.Context, id identifier.FunctionIdentifi
 		return function.Function{}, err
 	}
 
+	serviceConfig, err := toServiceConfig(res.GetServiceConfig())
+	if err != nil {
+		return function.Function{}, err
+	}
+
+	eventTriggers, err := toEventTriggers(id.Location, res.GetEventTrigger())
+	if err != nil {
+		return function.Function{}, err
+	}
+
 	return function.Function{
 		Identifier: id,
 		Config: function.Config{
@@ -101,6 +133,8 @@ func (c *client) GetFunction(ctx context.Context, id identifier.FunctionIdentifi
 					Checksum: fmt.Sprintf("%d", objectAttrs.CRC32C),
 				},
 			},
+			ServiceConfig: serviceConfig,
+			EventTrigger:  eventTriggers,
 		},
 		Attrs: function.Attrs{
 			Url: res.Url,
@@ -131,6 +165,16 @@ func (c *client) CreateFunction(ctx context.Context, id identifier.FunctionIdent
 		return function.Function{}, err
 	}
 
+	sc, err := toServiceConfigPB(config.ServiceConfig)
+	if err != nil {
+		return function.Function{}, err
+	}
+
+	eventTrigger, err := toEventTriggerPB(id.Location, config.EventTrigger)
+	if err != nil {
+		return function.Function{}, err
+	}
+
 	operation, err := c.GCP.CreateFunction(ctx, &functionspb.CreateFunctionRequest{
 		Parent:     fmt.Sprintf("projects/%s/locations/%s", id.Project, id.Location),
 		FunctionId: id.Name,
@@ -148,6 +192,8 @@ func (c *client) CreateFunction(ctx context.Context, id identifier.FunctionIdent
 					},
 				},
 			},
+			ServiceConfig: sc,
+			EventTrigger:  eventTrigger,
 		},
 	})
 	if err != nil {
@@ -165,6 +211,16 @@ func (c *client) CreateFunction(ctx context.Context, id identifier.FunctionIdent
 		return function.Function{}, err
 	}
 
+	serviceConfig, err := toServiceConfig(res.GetServiceConfig())
+	if err != nil {
+		return function.Function{}, err
+	}
+
+	eventTriggers, err := toEventTriggers(id.Location, res.GetEventTrigger())
+	if err != nil {
+		return function.Function{}, err
+	}
+
 	return function.Function{
 		Identifier: id,
 		Config: function.Config{
@@ -177,6 +233,8 @@ func (c *client) CreateFunction(ctx context.Context, id identifier.FunctionIdent
 					Checksum: fmt.Sprintf("%d", objectAttrs.CRC32C),
 				},
 			},
+			ServiceConfig: serviceConfig,
+			EventTrigger:  eventTriggers,
 		},
 		Attrs: function.Attrs{
 			Url: res.Url,
@@ -241,6 +299,45 @@ func (c *client) UpdateFunction(ctx context.Context, id identifier.FunctionIdent
 				}
 			}
 			updateFunc.BuildConfig = &bc
+		case "service_config":
+			sc, err := toServiceConfigPB(config.ServiceConfig)
+			if err != nil {
+				return function.Function{}, err
+			}
+			for _, f := range m.SubFields {
+				switch f.Name {
+				case "available_memory":
+					updateMask.Paths = append(updateMask.Paths, "service_config.available_memory")
+				case "timeout_seconds":
+					updateMask.Paths = append(updateMask.Paths, "service_config.timeout_seconds")
+				case "min_instance_count":
+					updateMask.Paths = append(updateMask.Paths, "service_config.min_instance_count")
+				case "max_instance_count":
+					updateMask.Paths = append(updateMask.Paths, "service_config.max_instance_count")
+				case "max_instance_request_concurrency":
+					updateMask.Paths = append(updateMask.Paths, "service_config.max_instance_request_concurrency")
+				case "ingress_settings":
+					updateMask.Paths = append(updateMask.Paths, "service_config.ingress_settings")
+				case "vpc_connector":
+					updateMask.Paths = append(updateMask.Paths, "service_config.vpc_connector")
+				case "environment_variables":
+					updateMask.Paths = append(updateMask.Paths, "service_config.environment_variables")
+				case "service_account":
+					updateMask.Paths = append(updateMask.Paths, "service_config.service_account_email")
+				case "secret_environment_variables":
+					updateMask.Paths = append(updateMask.Paths, "service_config.secret_environment_variables")
+				case "secret_volumes":
+					updateMask.Paths = append(updateMask.Paths, "service_config.secret_volumes")
+				}
+			}
+			updateFunc.ServiceConfig = sc
+		case "event_trigger":
+			eventTrigger, err := toEventTriggerPB(id.Location, config.EventTrigger)
+			if err != nil {
+				return function.Function{}, err
+			}
+			updateFunc.EventTrigger = eventTrigger
+			updateMask.Paths = append(updateMask.Paths, "event_trigger")
 		}
 	}
 
@@ -263,6 +360,16 @@ func (c *client) UpdateFunction(ctx context.Context, id identifier.FunctionIdent
 		return function.Function{}, err
 	}
 
+	serviceConfig, err := toServiceConfig(res.GetServiceConfig())
+	if err != nil {
+		return function.Function{}, err
+	}
+
+	eventTriggers, err := toEventTriggers(id.Location, res.GetEventTrigger())
+	if err != nil {
+		return function.Function{}, err
+	}
+
 	return function.Function{
 		Identifier: id,
 		Config: function.Config{
@@ -275,6 +382,8 @@ func (c *client) UpdateFunction(ctx context.Context, id identifier.FunctionIdent
 					Checksum: fmt.Sprintf("%d", objectAttrs.CRC32C),
 				},
 			},
+			ServiceConfig: serviceConfig,
+			EventTrigger:  eventTriggers,
 		},
 		Attrs: function.Attrs{
 			Url: res.Url,
@@ -292,3 +401,268 @@ func (c *client) DeleteFunction(ctx context.Context, id identifier.FunctionIdent
 
 	return operation.Wait(ctx)
 }
+
+func toServiceConfigPB(config function.ServiceConfig) (*functionspb.ServiceConfig, error) {
+	serviceAccount, err := iamcodec.FormatRuntimeServiceAccountEmail(config.ServiceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("field service_config.service_account: %w", err)
+	}
+
+	ingressName, err := ingressSettingsField.Parse(config.IngressSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, ok := functionspb.ServiceConfig_IngressSettings_value[ingressName]
+	if !ok {
+		return nil, fmt.Errorf("invalid value for service_config.ingress_settings: %q", config.IngressSettings)
+	}
+
+	availableMemory, err := availableMemoryField.Parse(config.AvailableMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := timeoutSecondsField.Parse(config.TimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	minInstances, err := minInstanceCountField.Parse(config.MinInstanceCount)
+	if err != nil {
+		return nil, err
+	}
+
+	maxInstances, err := maxInstanceCountField.Parse(config.MaxInstanceCount)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency, err := concurrencyField.Parse(config.MaxInstanceRequestConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	secretEnvVars := make([]*functionspb.SecretEnvVar, len(config.SecretEnvironmentVariables))
+	for i, e := range config.SecretEnvironmentVariables {
+		secretID, ok := e.Secret.(identifier.SecretIdentifier)
+		if !ok {
+			return nil, fmt.Errorf("field secret must be a secret identifier")
+		}
+
+		secretEnvVars[i] = &functionspb.SecretEnvVar{
+			Key:       e.Key,
+			ProjectId: secretID.Project,
+			Secret:    secretID.Name,
+			Version:   e.Version,
+		}
+	}
+
+	secretVolumes := make([]*functionspb.SecretVolume, len(config.SecretVolumes))
+	for i, v := range config.SecretVolumes {
+		secretID, ok := v.Secret.(identifier.SecretIdentifier)
+		if !ok {
+			return nil, fmt.Errorf("field secret must be a secret identifier")
+		}
+
+		secretVolumes[i] = &functionspb.SecretVolume{
+			MountPath: v.MountPath,
+			ProjectId: secretID.Project,
+			Secret:    secretID.Name,
+			Versions: []*functionspb.SecretVolume_SecretVersion{
+				{
+					Version: v.Version,
+					Path:    v.Path,
+				},
+			},
+		}
+	}
+
+	return &functionspb.ServiceConfig{
+		AvailableMemory:               availableMemory,
+		TimeoutSeconds:                timeout,
+		MinInstanceCount:              minInstances,
+		MaxInstanceCount:              maxInstances,
+		MaxInstanceRequestConcurrency: concurrency,
+		IngressSettings:               functionspb.ServiceConfig_IngressSettings(ingress),
+		VpcConnector:                  config.VpcConnector,
+		EnvironmentVariables:          config.EnvironmentVariables,
+		ServiceAccountEmail:           serviceAccount,
+		SecretEnvironmentVariables:    secretEnvVars,
+		SecretVolumes:                 secretVolumes,
+	}, nil
+}
+
+func toServiceConfig(sc *functionspb.ServiceConfig) (function.ServiceConfig, error) {
+	serviceAccount, err := iamcodec.ParseRuntimeServiceAccountEmail(sc.GetServiceAccountEmail())
+	if err != nil {
+		return function.ServiceConfig{}, err
+	}
+
+	secretEnvVars := make([]function.SecretEnvironmentVariable, len(sc.GetSecretEnvironmentVariables()))
+	for i, e := range sc.GetSecretEnvironmentVariables() {
+		secretEnvVars[i] = function.SecretEnvironmentVariable{
+			Key: e.GetKey(),
+			Secret: identifier.SecretIdentifier{
+				Project: e.GetProjectId(),
+				Name:    e.GetSecret(),
+			},
+			Version: e.GetVersion(),
+		}
+	}
+
+	secretVolumes := make([]function.SecretVolume, len(sc.GetSecretVolumes()))
+	for i, v := range sc.GetSecretVolumes() {
+		// Volumes managed by this provider always mount a single version.
+		var version *functionspb.SecretVolume_SecretVersion
+		if len(v.GetVersions()) > 0 {
+			version = v.GetVersions()[0]
+		}
+
+		secretVolumes[i] = function.SecretVolume{
+			MountPath: v.GetMountPath(),
+			Secret: identifier.SecretIdentifier{
+				Project: v.GetProjectId(),
+				Name:    v.GetSecret(),
+			},
+			Version: version.GetVersion(),
+			Path:    version.GetPath(),
+		}
+	}
+
+	return function.ServiceConfig{
+		AvailableMemory:               availableMemoryField.Format(sc.GetAvailableMemory()),
+		TimeoutSeconds:                timeoutSecondsField.Format(sc.GetTimeoutSeconds()),
+		MinInstanceCount:              minInstanceCountField.Format(sc.GetMinInstanceCount()),
+		MaxInstanceCount:              maxInstanceCountField.Format(sc.GetMaxInstanceCount()),
+		MaxInstanceRequestConcurrency: concurrencyField.Format(sc.GetMaxInstanceRequestConcurrency()),
+		IngressSettings:               ingressSettingsField.Format(sc.GetIngressSettings().String()),
+		VpcConnector:                  sc.GetVpcConnector(),
+		EnvironmentVariables:          sc.GetEnvironmentVariables(),
+		ServiceAccount:                serviceAccount,
+		SecretEnvironmentVariables:    secretEnvVars,
+		SecretVolumes:                 secretVolumes,
+	}, nil
+}
+
+func toEventTriggerPB(location string, triggers []function.EventTrigger) (*functionspb.EventTrigger, error) {
+	switch len(triggers) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("at most one event_trigger can be set, got %d", len(triggers))
+	}
+
+	trigger := triggers[0]
+	topic, err := toPubsubTopicPB(trigger.EventType, trigger.PubsubTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccount, err := iamcodec.FormatRuntimeServiceAccountEmail(trigger.ServiceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("field event_trigger.service_account: %w", err)
+	}
+
+	triggerRegion, err := triggerRegionField(location).Parse(trigger.TriggerRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicyName, err := retryPolicyField.Parse(trigger.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, ok := functionspb.EventTrigger_RetryPolicy_value[retryPolicyName]
+	if !ok {
+		return nil, fmt.Errorf("invalid value for event_trigger.retry_policy: %q", trigger.RetryPolicy)
+	}
+
+	filters := make([]*functionspb.EventFilter, len(trigger.EventFilters))
+	for i, f := range trigger.EventFilters {
+		filters[i] = &functionspb.EventFilter{
+			Attribute: f.Attribute,
+			Value:     f.Value,
+			Operator:  f.Operator,
+		}
+	}
+
+	return &functionspb.EventTrigger{
+		EventType:           trigger.EventType,
+		PubsubTopic:         topic,
+		EventFilters:        filters,
+		RetryPolicy:         functionspb.EventTrigger_RetryPolicy(retryPolicy),
+		TriggerRegion:       triggerRegion,
+		ServiceAccountEmail: serviceAccount,
+	}, nil
+}
+
+// toPubsubTopicPB returns the trigger's topic. A topic is required for Pub/Sub triggers and can't be set for any other event type.
+func toPubsubTopicPB(eventType string, topics []value.ResourceIdentifier) (string, error) {
+	if eventType != pubsubEventType {
+		if len(topics) > 0 {
+			return "", fmt.Errorf("field pubsub_topic can only be set for %s events", pubsubEventType)
+		}
+
+		return "", nil
+	}
+
+	if len(topics) != 1 {
+		return "", fmt.Errorf("exactly one pubsub_topic must be set for %s events, got %d", pubsubEventType, len(topics))
+	}
+
+	topicID, ok := topics[0].(identifier.PubsubTopicIdentifier)
+	if !ok {
+		return "", fmt.Errorf("field pubsub_topic must be a pubsub_topic identifier")
+	}
+
+	return fmt.Sprintf("projects/%s/topics/%s", topicID.Project, topicID.Name), nil
+}
+
+func toEventTriggers(location string, trigger *functionspb.EventTrigger) ([]function.EventTrigger, error) {
+	if trigger == nil {
+		return nil, nil
+	}
+
+	// GCP may report the transport topic for other event types, which isn't part of the config.
+	var topics []value.ResourceIdentifier
+	if trigger.GetEventType() == pubsubEventType {
+		// Has the form of projects/<project>/topics/<name>
+		topicParts := strings.Split(trigger.GetPubsubTopic(), "/")
+		if len(topicParts) != 4 {
+			return nil, fmt.Errorf("invalid pubsub topic in response: %q", trigger.GetPubsubTopic())
+		}
+
+		topics = append(topics, identifier.PubsubTopicIdentifier{
+			Project: topicParts[1],
+			Name:    topicParts[3],
+		})
+	}
+
+	serviceAccount, err := iamcodec.ParseRuntimeServiceAccountEmail(trigger.GetServiceAccountEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]function.EventFilter, len(trigger.GetEventFilters()))
+	for i, f := range trigger.GetEventFilters() {
+		filters[i] = function.EventFilter{
+			Attribute: f.GetAttribute(),
+			Value:     f.GetValue(),
+			Operator:  f.GetOperator(),
+		}
+	}
+
+	return []function.EventTrigger{
+		{
+			EventType:      trigger.GetEventType(),
+			PubsubTopic:    topics,
+			EventFilters:   filters,
+			RetryPolicy:    retryPolicyField.Format(trigger.GetRetryPolicy().String()),
+			TriggerRegion:  triggerRegionField(location).Format(trigger.GetTriggerRegion()),
+			ServiceAccount: serviceAccount,
+		},
+	}, nil
+}
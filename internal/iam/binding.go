@@ -0,0 +1,38 @@
+package iam
+
+import (
+	"strings"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/genproto/googleapis/type/expr"
+)
+
+// Condition returns the condition of a binding, or nil for an unconditional binding.
+func Condition(title, description, expression string) *expr.Expr {
+	if expression == "" {
+		return nil
+	}
+
+	return &expr.Expr{
+		Title:       title,
+		Description: description,
+		Expression:  expression,
+	}
+}
+
+// BindingKey identifies a binding within a policy. GCP keeps a separate binding for each condition on a role.
+func BindingKey(role string, condition *expr.Expr) string {
+	return strings.Join([]string{role, condition.GetTitle(), condition.GetDescription(), condition.GetExpression()}, "\x00")
+}
+
+// FindBinding returns the binding of the policy for the role and condition, or nil if there is none.
+func FindBinding(policy *iampb.Policy, role string, condition *expr.Expr) *iampb.Binding {
+	key := BindingKey(role, condition)
+	for _, b := range policy.GetBindings() {
+		if BindingKey(b.GetRole(), b.GetCondition()) == key {
+			return b
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxAttempts = 5
+	baseBackoff = 500 * time.Millisecond
+	maxBackoff  = 8 * time.Second
+)
+
+// Modify reads the policy, applies modify to it and writes it back. The write is guarded by the etag of the
+// policy that was read. When another writer got in first, the read-modify-write starts over after a jittered
+// backoff, so that concurrent writers converge instead of failing.
+func Modify(ctx context.Context, store Store, modify func(*iampb.Policy) error) (*iampb.Policy, error) {
+	backoff := baseBackoff
+	for attempt := 1; ; attempt++ {
+		policy, err := store.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := modify(policy); err != nil {
+			return nil, err
+		}
+
+		// Conditional bindings need version 3.
+		policy.Version = 3
+
+		res, err := store.Set(ctx, policy)
+		if err == nil {
+			return res, nil
+		}
+
+		if !isConflict(err) || attempt == maxAttempts {
+			return nil, err
+		}
+
+		// Full jitter keeps writers that conflicted with each other from retrying in lockstep.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(rand.Int63n(int64(backoff)))):
+		}
+
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// isConflict reports whether the write failed because the policy changed after it was read. gRPC APIs report an
+// etag mismatch as ABORTED or FAILED_PRECONDITION, and HTTP APIs as 409 or 412.
+func isConflict(err error) bool {
+	switch status.Code(err) {
+	case codes.Aborted, codes.FailedPrecondition:
+		return true
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusConflict || apiErr.Code == http.StatusPreconditionFailed
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/iam/apiv1/iampb"
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"cloud.google.com/go/storage"
+
+	cloudfunction "cloud.google.com/go/functions/apiv2"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GCP interface {
+	GetIamPolicy(context.Context, *iampb.GetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
+	SetIamPolicy(context.Context, *iampb.SetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
+}
+
+type Storage interface {
+	Bucket(string) *storage.BucketHandle
+}
+
+// Policies gives access to the IAM policies of the resource types that support them.
+type Policies struct {
+	CloudFunction GCP
+	Project       GCP
+	Storage       Storage
+
+	closers []func() error
+}
+
+func NewPolicies(ctx context.Context) (*Policies, error) {
+	fc, err := cloudfunction.NewFunctionRESTClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := resourcemanager.NewProjectsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Policies{
+		CloudFunction: fc,
+		Project:       pc,
+		Storage:       sc,
+		closers:       []func() error{fc.Close, pc.Close, sc.Close},
+	}, nil
+}
+
+func (p *Policies) Close() error {
+	errs := make([]error, len(p.closers))
+	for i, c := range p.closers {
+		errs[i] = c()
+	}
+
+	return errors.Join(errs...)
+}
+
+// Store returns the store used to manage the IAM policy of the given resource.
+func (p *Policies) Store(resource value.ResourceIdentifier) (Store, error) {
+	switch resourceID := resource.(type) {
+	case identifier.FunctionIdentifier:
+		return &gcpStore{
+			GCP:      p.CloudFunction,
+			Resource: fmt.Sprintf("projects/%s/locations/%s/functions/%s", resourceID.Project, resourceID.Location, resourceID.Name),
+		}, nil
+	case identifier.ProjectIdentifier:
+		return &gcpStore{
+			GCP:      p.Project,
+			Resource: fmt.Sprintf("projects/%s", resourceID.ProjectId),
+		}, nil
+	case identifier.BucketIdentifier:
+		return &bucketStore{
+			Bucket: p.Storage.Bucket(resourceID.Name),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported identifier type: %T", resource)
+	}
+}
+
+// Store reads and writes the IAM policy of a single resource. Set must be given the policy returned by the
+// last call to Get, so that the write is conditioned on its etag.
+type Store interface {
+	Get(context.Context) (*iampb.Policy, error)
+	Set(context.Context, *iampb.Policy) (*iampb.Policy, error)
+}
+
+type gcpStore struct {
+	GCP      GCP
+	Resource string
+}
+
+func (s *gcpStore) Get(ctx context.Context) (*iampb.Policy, error) {
+	res, err := s.GCP.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
+		Resource: s.Resource,
+		Options: &iampb.GetPolicyOptions{
+			RequestedPolicyVersion: 3,
+		},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, sdkerrors.NewErrorNotFound()
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *gcpStore) Set(ctx context.Context, policy *iampb.Policy) (*iampb.Policy, error) {
+	return s.GCP.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+		Resource: s.Resource,
+		Policy:   policy,
+	})
+}
+
+// bucketStore manages bucket policies through the storage client. The storage client keeps the etag of a
+// policy to itself, so the policy from the last Get is held on to and the new bindings are written through it.
+type bucketStore struct {
+	Bucket *storage.BucketHandle
+
+	policy *iam.Policy3
+}
+
+func (s *bucketStore) Get(ctx context.Context) (*iampb.Policy, error) {
+	attrs, err := s.Bucket.Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrBucketNotExist) {
+			return nil, sdkerrors.NewErrorNotFound()
+		}
+
+		return nil, err
+	}
+
+	// Without uniform bucket-level access, object ACLs grant access alongside the policy, so the policy
+	// wouldn't be authoritative. The policy also holds legacy ACL roles that can't be managed as bindings.
+	if !attrs.UniformBucketLevelAccess.Enabled {
+		return nil, fmt.Errorf("bucket %s must have uniform bucket-level access enabled to manage its IAM policy", attrs.Name)
+	}
+
+	policy, err := s.Bucket.IAM().V3().Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.policy = policy
+
+	return &iampb.Policy{
+		Version:  3,
+		Bindings: policy.Bindings,
+	}, nil
+}
+
+func (s *bucketStore) Set(ctx context.Context, policy *iampb.Policy) (*iampb.Policy, error) {
+	if s.policy == nil {
+		return nil, fmt.Errorf("bucket policy must be read before it's written")
+	}
+
+	s.policy.Bindings = policy.GetBindings()
+	if err := s.Bucket.IAM().V3().SetPolicy(ctx, s.policy); err != nil {
+		return nil, err
+	}
+
+	// SetPolicy doesn't return the stored policy, so it's read back.
+	return s.Get(ctx)
+}
+
+// FullResourceName returns the name that IAM APIs outside of the resource's own service refer to it by.
+func FullResourceName(resource value.ResourceIdentifier) (string, error) {
+	switch resourceID := resource.(type) {
+	case identifier.FunctionIdentifier:
+		return fmt.Sprintf("//cloudfunctions.googleapis.com/projects/%s/locations/%s/functions/%s", resourceID.Project, resourceID.Location, resourceID.Name), nil
+	case identifier.ProjectIdentifier:
+		return fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", resourceID.ProjectId), nil
+	case identifier.BucketIdentifier:
+		return fmt.Sprintf("//storage.googleapis.com/projects/_/buckets/%s", resourceID.Name), nil
+	default:
+		return "", fmt.Errorf("unsupported identifier type: %T", resource)
+	}
+}
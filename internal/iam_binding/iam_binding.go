@@ -0,0 +1,171 @@
+package iam_binding
+
+import (
+	"context"
+	"fmt"
+
+	iambinding "github.com/alchematik/athanor-provider-gcp/gen/provider/iam_binding"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam"
+	"github.com/alchematik/athanor-provider-gcp/internal/iamcodec"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	"google.golang.org/genproto/googleapis/type/expr"
+)
+
+func NewHandler(ctx context.Context) (*iambinding.IamBindingHandler, error) {
+	policies, err := iam.NewPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		Policies: policies,
+	}
+
+	return &iambinding.IamBindingHandler{
+		IamBindingGetter:  c,
+		IamBindingCreator: c,
+		IamBindingUpdator: c,
+		IamBindingDeleter: c,
+		CloseFunc:         policies.Close,
+	}, nil
+}
+
+// The binding for the role and condition is managed authoritatively, while the rest of the policy is left alone.
+type client struct {
+	Policies *iam.Policies
+}
+
+func (c *client) GetIamBinding(ctx context.Context, id identifier.IamBindingIdentifier) (iambinding.IamBinding, error) {
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	role, err := iamcodec.FormatRole(id.Role)
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	res, err := store.Get(ctx)
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	if iam.FindBinding(res, role, condition(id)) == nil {
+		return iambinding.IamBinding{}, sdkerrors.NewErrorNotFound()
+	}
+
+	return toIamBinding(id, res)
+}
+
+func (c *client) CreateIamBinding(ctx context.Context, id identifier.IamBindingIdentifier, config iambinding.Config) (iambinding.IamBinding, error) {
+	return c.setMembers(ctx, id, config.Members)
+}
+
+func (c *client) UpdateIamBinding(ctx context.Context, id identifier.IamBindingIdentifier, config iambinding.Config, mask []value.UpdateMaskField) (iambinding.IamBinding, error) {
+	return c.setMembers(ctx, id, config.Members)
+}
+
+func (c *client) DeleteIamBinding(ctx context.Context, id identifier.IamBindingIdentifier) error {
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return err
+	}
+
+	role, err := iamcodec.FormatRole(id.Role)
+	if err != nil {
+		return err
+	}
+
+	key := iam.BindingKey(role, condition(id))
+	_, err = iam.Modify(ctx, store, func(policy *iampb.Policy) error {
+		var bindings []*iampb.Binding
+		for _, b := range policy.GetBindings() {
+			if iam.BindingKey(b.GetRole(), b.GetCondition()) != key {
+				bindings = append(bindings, b)
+			}
+		}
+		policy.Bindings = bindings
+
+		return nil
+	})
+	return err
+}
+
+func (c *client) setMembers(ctx context.Context, id identifier.IamBindingIdentifier, members []value.ResourceIdentifier) (iambinding.IamBinding, error) {
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	role, err := iamcodec.FormatRole(id.Role)
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	names := make([]string, len(members))
+	for i, m := range members {
+		name, err := iamcodec.FormatMember(m)
+		if err != nil {
+			return iambinding.IamBinding{}, err
+		}
+
+		names[i] = name
+	}
+
+	res, err := iam.Modify(ctx, store, func(policy *iampb.Policy) error {
+		binding := iam.FindBinding(policy, role, condition(id))
+		if binding == nil {
+			binding = &iampb.Binding{
+				Role:      role,
+				Condition: condition(id),
+			}
+			policy.Bindings = append(policy.Bindings, binding)
+		}
+		binding.Members = names
+
+		return nil
+	})
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	return toIamBinding(id, res)
+}
+
+func condition(id identifier.IamBindingIdentifier) *expr.Expr {
+	return iam.Condition(id.ConditionTitle, id.ConditionDescription, id.ConditionExpression)
+}
+
+func toIamBinding(id identifier.IamBindingIdentifier, res *iampb.Policy) (iambinding.IamBinding, error) {
+	role, err := iamcodec.FormatRole(id.Role)
+	if err != nil {
+		return iambinding.IamBinding{}, err
+	}
+
+	binding := iam.FindBinding(res, role, condition(id))
+
+	members := make([]value.ResourceIdentifier, len(binding.GetMembers()))
+	for i, m := range binding.GetMembers() {
+		member, err := iamcodec.ParseMember(m)
+		if err != nil {
+			return iambinding.IamBinding{}, err
+		}
+
+		members[i] = member
+	}
+
+	return iambinding.IamBinding{
+		Identifier: id,
+		Config: iambinding.Config{
+			Members: members,
+		},
+		Attrs: iambinding.Attrs{
+			Etag: fmt.Sprintf("%x", res.GetEtag()),
+		},
+	}, nil
+}
@@ -0,0 +1,152 @@
+package iam_member
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	iammember "github.com/alchematik/athanor-provider-gcp/gen/provider/iam_member"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam"
+	"github.com/alchematik/athanor-provider-gcp/internal/iamcodec"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
+)
+
+func NewHandler(ctx context.Context) (*iammember.IamMemberHandler, error) {
+	policies, err := iam.NewPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		Policies: policies,
+	}
+
+	// Everything about a member is in its identifier, so there's nothing to update.
+	return &iammember.IamMemberHandler{
+		IamMemberGetter:  c,
+		IamMemberCreator: c,
+		IamMemberDeleter: c,
+		CloseFunc:        policies.Close,
+	}, nil
+}
+
+// Only the member's entry in the binding for the role and condition is managed. Other members of the binding and
+// the rest of the policy are left alone.
+type client struct {
+	Policies *iam.Policies
+}
+
+func (c *client) GetIamMember(ctx context.Context, id identifier.IamMemberIdentifier) (iammember.IamMember, error) {
+	store, role, member, err := c.resolve(id)
+	if err != nil {
+		return iammember.IamMember{}, err
+	}
+
+	res, err := store.Get(ctx)
+	if err != nil {
+		return iammember.IamMember{}, err
+	}
+
+	binding := iam.FindBinding(res, role, condition(id))
+	if !slices.Contains(binding.GetMembers(), member) {
+		return iammember.IamMember{}, sdkerrors.NewErrorNotFound()
+	}
+
+	return toIamMember(id, res), nil
+}
+
+func (c *client) CreateIamMember(ctx context.Context, id identifier.IamMemberIdentifier, config iammember.Config) (iammember.IamMember, error) {
+	store, role, member, err := c.resolve(id)
+	if err != nil {
+		return iammember.IamMember{}, err
+	}
+
+	res, err := iam.Modify(ctx, store, func(policy *iampb.Policy) error {
+		binding := iam.FindBinding(policy, role, condition(id))
+		if binding == nil {
+			binding = &iampb.Binding{
+				Role:      role,
+				Condition: condition(id),
+			}
+			policy.Bindings = append(policy.Bindings, binding)
+		}
+
+		if !slices.Contains(binding.Members, member) {
+			binding.Members = append(binding.Members, member)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return iammember.IamMember{}, err
+	}
+
+	return toIamMember(id, res), nil
+}
+
+func (c *client) DeleteIamMember(ctx context.Context, id identifier.IamMemberIdentifier) error {
+	store, role, member, err := c.resolve(id)
+	if err != nil {
+		return err
+	}
+
+	key := iam.BindingKey(role, condition(id))
+	_, err = iam.Modify(ctx, store, func(policy *iampb.Policy) error {
+		var bindings []*iampb.Binding
+		for _, b := range policy.GetBindings() {
+			if iam.BindingKey(b.GetRole(), b.GetCondition()) == key {
+				b.Members = slices.DeleteFunc(b.Members, func(m string) bool {
+					return m == member
+				})
+
+				// A binding can't be left without members.
+				if len(b.Members) == 0 {
+					continue
+				}
+			}
+
+			bindings = append(bindings, b)
+		}
+		policy.Bindings = bindings
+
+		return nil
+	})
+	return err
+}
+
+func (c *client) resolve(id identifier.IamMemberIdentifier) (iam.Store, string, string, error) {
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	role, err := iamcodec.FormatRole(id.Role)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	member, err := iamcodec.FormatMember(id.Member)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return store, role, member, nil
+}
+
+func condition(id identifier.IamMemberIdentifier) *expr.Expr {
+	return iam.Condition(id.ConditionTitle, id.ConditionDescription, id.ConditionExpression)
+}
+
+func toIamMember(id identifier.IamMemberIdentifier, res *iampb.Policy) iammember.IamMember {
+	return iammember.IamMember{
+		Identifier: id,
+		Config:     iammember.Config{},
+		Attrs: iammember.Attrs{
+			Etag: fmt.Sprintf("%x", res.GetEtag()),
+		},
+	}
+}
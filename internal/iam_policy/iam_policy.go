@@ -3,40 +3,26 @@ package iam_policy
 import (
 	"context"
 	"fmt"
-	"log"
-	"regexp"
-	"strings"
+	"sort"
 
 	iampolicy "github.com/alchematik/athanor-provider-gcp/gen/provider/iam_policy"
 	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam"
+	"github.com/alchematik/athanor-provider-gcp/internal/iamcodec"
 
 	"cloud.google.com/go/iam/apiv1/iampb"
-	// resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
-	// cloudrun "cloud.google.com/go/run/apiv2"
-
-	cloudfunction "cloud.google.com/go/functions/apiv2"
 	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
 	"github.com/alchematik/athanor-go/sdk/provider/value"
-	"github.com/googleapis/gax-go/v2"
-)
-
-var (
-	customServiceAccountRe = regexp.MustCompile(`serviceAccount:(.+)@(.+).iam.gserviceaccount.com`)
 )
 
 func NewHandler(ctx context.Context) (*iampolicy.IamPolicyHandler, error) {
-	// cloudRun, err := cloudrun.NewServicesClient(ctx)
-	// if err != nil {
-	// 	return nil, err
-	// }
-
-	fc, err := cloudfunction.NewFunctionRESTClient(ctx)
+	policies, err := iam.NewPolicies(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &client{
-		CloudFunction: fc,
+		Policies: policies,
 	}
 
 	return &iampolicy.IamPolicyHandler{
@@ -44,351 +30,176 @@ func NewHandler(ctx context.Context) (*iampolicy.IamPolicyHandler, error) {
 		IamPolicyDeleter: c,
 		IamPolicyGetter:  c,
 		IamPolicyUpdator: c,
+		CloseFunc:        policies.Close,
 	}, nil
 }
 
-type GCP interface {
-	GetIamPolicy(context.Context, *iampb.GetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
-	SetIamPolicy(context.Context, *iampb.SetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
-}
-
 type client struct {
-	CloudFunction GCP
+	Policies *iam.Policies
 }
 
 func (c *client) GetIamPolicy(ctx context.Context, id identifier.IamPolicyIdentifier) (iampolicy.IamPolicy, error) {
-	switch resourceID := id.Resource.(type) {
-	case identifier.FunctionIdentifier:
-		resource := fmt.Sprintf("projects/%s/locations/%s/functions/%s", resourceID.Project, resourceID.Location, resourceID.Name)
-		res, err := c.CloudFunction.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
-			Resource: resource,
-		})
-		if err != nil {
-			return iampolicy.IamPolicy{}, err
-		}
-
-		if len(res.Bindings) == 0 {
-			return iampolicy.IamPolicy{}, sdkerrors.NewErrorNotFound()
-		}
-
-		bindings := make([]iampolicy.Binding, len(res.Bindings))
-		for i, binding := range res.Bindings {
-			members := make([]value.ResourceIdentifier, len(binding.Members))
-			for j, member := range binding.Members {
-				// TODO: Support "allUsers" and "allAuthenticatedUsers"
-				parts := strings.Split(member, ":")
-				if len(parts) == 0 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %s", member)
-				}
-				switch parts[0] {
-				case "serviceAccount":
-					// TODO: support default service account. Probably makes sense to create a different resource identifier type for those.
-					customServiceAccountParts := customServiceAccountRe.FindStringSubmatch(member)
-					if len(customServiceAccountParts) < 2 {
-						return iampolicy.IamPolicy{}, fmt.Errorf("invalid custom service account email: %s", member)
-					}
-
-					log.Printf("MEMBER >>>>>> %v\n", member)
-
-					members[j] = identifier.ServiceAccountIdentifier{
-						AccountId: customServiceAccountParts[1],
-						Project:   customServiceAccountParts[2],
-					}
-				default:
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %s", member)
-				}
-			}
-
-			var r value.ResourceIdentifier
-			switch {
-			case strings.HasPrefix(binding.Role, "roles/"):
-				parts := strings.Split(binding.Role, "/")
-				if len(parts) < 2 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("invalid pre-defined role: %s", binding.Role)
-				}
-
-				r = identifier.IamRoleIdentifier{
-					Name: parts[1],
-				}
-			case strings.HasPrefix(binding.Role, "projects/"):
-				parts := strings.Split(binding.Role, "/")
-				if len(parts) < 4 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("invalid custom project level role: %s", binding.Role)
-				}
-				r = identifier.IamRoleCustomProjectIdentifier{
-					Project: parts[1],
-					Name:    parts[3],
-				}
-			}
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return iampolicy.IamPolicy{}, err
+	}
 
-			bindings[i] = iampolicy.Binding{
-				Role:    r,
-				Members: members,
-			}
-		}
+	res, err := store.Get(ctx)
+	if err != nil {
+		return iampolicy.IamPolicy{}, err
+	}
 
-		return iampolicy.IamPolicy{
-			Identifier: id,
-			Config: iampolicy.Config{
-				Bindings: bindings,
-			},
-			Attrs: iampolicy.Attrs{
-				Etag: fmt.Sprintf("%x", res.GetEtag()),
-			},
-		}, nil
-	default:
-		return iampolicy.IamPolicy{}, fmt.Errorf("unsupported identifier type: %T", id)
+	if len(res.Bindings) == 0 {
+		return iampolicy.IamPolicy{}, sdkerrors.NewErrorNotFound()
 	}
+
+	return toIamPolicy(id, res)
 }
 
 func (c *client) CreateIamPolicy(ctx context.Context, id identifier.IamPolicyIdentifier, config iampolicy.Config) (iampolicy.IamPolicy, error) {
-	log.Printf("CREATING IAM POLICY>>>>>>>>>>>")
-	switch resourceID := id.Resource.(type) {
-	case identifier.FunctionIdentifier:
-		bindings := make([]*iampb.Binding, len(config.Bindings))
-		for i, b := range config.Bindings {
-			var roleID string
-			switch role := b.Role.(type) {
-			case identifier.IamRoleCustomProjectIdentifier:
-				roleID = fmt.Sprintf("projects/%s/roles/%s", role.Project, role.Name)
-			default:
-				return iampolicy.IamPolicy{}, fmt.Errorf("unsupported role type: %T", b.Role)
-			}
+	bindings, err := toBindingsPB(config.Bindings)
+	if err != nil {
+		return iampolicy.IamPolicy{}, err
+	}
 
-			members := make([]string, len(b.Members))
-			for i, m := range b.Members {
-				switch m := m.(type) {
-				case identifier.ServiceAccountIdentifier:
-					members[i] = fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", m.AccountId, m.Project)
-				default:
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %T", m)
-				}
-			}
+	res, err := c.setPolicy(ctx, id, bindings)
+	if err != nil {
+		return iampolicy.IamPolicy{}, err
+	}
 
-			bindings[i] = &iampb.Binding{
-				Role:    roleID,
-				Members: members,
-			}
-		}
+	return toIamPolicy(id, res)
+}
 
-		res, err := c.CloudFunction.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
-			Resource: fmt.Sprintf("projects/%s/locations/%s/functions/%s", resourceID.Project, resourceID.Location, resourceID.Name),
-			Policy: &iampb.Policy{
-				Version:  3,
-				Bindings: bindings,
-			},
-		})
-		if err != nil {
-			return iampolicy.IamPolicy{}, err
-		}
+func (c *client) UpdateIamPolicy(ctx context.Context, id identifier.IamPolicyIdentifier, config iampolicy.Config, mask []value.UpdateMaskField) (iampolicy.IamPolicy, error) {
+	bindings, err := toBindingsPB(config.Bindings)
+	if err != nil {
+		return iampolicy.IamPolicy{}, err
+	}
 
-		binds := make([]iampolicy.Binding, len(res.Bindings))
-		for i, binding := range res.Bindings {
-			members := make([]value.ResourceIdentifier, len(binding.Members))
-			for j, member := range binding.Members {
-				// TODO: Support "allUsers" and "allAuthenticatedUsers"
-				parts := strings.Split(member, ":")
-				if len(parts) == 0 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %s", member)
-				}
-				switch parts[0] {
-				case "serviceAccount":
-					// TODO: support default service account. Probably makes sense to create a different resource identifier type for those.
-					customServiceAccountParts := customServiceAccountRe.FindStringSubmatch(member)
-					if len(customServiceAccountParts) < 2 {
-						return iampolicy.IamPolicy{}, fmt.Errorf("invalid custom service account email: %s", member)
-					}
-
-					members[j] = identifier.ServiceAccountIdentifier{
-						AccountId: customServiceAccountParts[1],
-						Project:   customServiceAccountParts[2],
-					}
-				default:
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %s", member)
-				}
-			}
+	res, err := c.setPolicy(ctx, id, bindings)
+	if err != nil {
+		return iampolicy.IamPolicy{}, err
+	}
 
-			var r value.ResourceIdentifier
-			switch {
-			case strings.HasPrefix(binding.Role, "roles/"):
-				parts := strings.Split(binding.Role, "/")
-				if len(parts) < 2 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("invalid pre-defined role: %s", binding.Role)
-				}
-
-				r = identifier.IamRoleIdentifier{
-					Name: parts[1],
-				}
-			case strings.HasPrefix(binding.Role, "projects/"):
-				parts := strings.Split(binding.Role, "/")
-				if len(parts) < 4 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("invalid custom project level role: %s", binding.Role)
-				}
-				r = identifier.IamRoleCustomProjectIdentifier{
-					Project: parts[1],
-					Name:    parts[3],
-				}
-			}
+	return toIamPolicy(id, res)
+}
+
+// DeleteIamPolicy removes every binding from the policy, except for a project's roles/owner bindings. Removing those
+// would lock everyone out of the project, so they are kept as they are.
+func (c *client) DeleteIamPolicy(ctx context.Context, id identifier.IamPolicyIdentifier) error {
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return err
+	}
 
-			binds[i] = iampolicy.Binding{
-				Role:    r,
-				Members: members,
+	_, isProject := id.Resource.(identifier.ProjectIdentifier)
+	_, err = iam.Modify(ctx, store, func(policy *iampb.Policy) error {
+		var kept []*iampb.Binding
+		for _, b := range policy.Bindings {
+			if isProject && b.Role == "roles/owner" {
+				kept = append(kept, b)
 			}
 		}
 
-		return iampolicy.IamPolicy{
-			Identifier: id,
-			Config: iampolicy.Config{
-				Bindings: binds,
-			},
-			Attrs: iampolicy.Attrs{
-				Etag: fmt.Sprintf("%x", res.GetEtag()),
-			},
-		}, nil
-	default:
-		return iampolicy.IamPolicy{}, fmt.Errorf("unsupported identifier type: %T", id)
+		policy.Bindings = kept
+		return nil
+	})
+	return err
+}
+
+// setPolicy replaces the bindings of the policy.
+func (c *client) setPolicy(ctx context.Context, id identifier.IamPolicyIdentifier, bindings []*iampb.Binding) (*iampb.Policy, error) {
+	store, err := c.Policies.Store(id.Resource)
+	if err != nil {
+		return nil, err
 	}
+
+	return iam.Modify(ctx, store, func(policy *iampb.Policy) error {
+		policy.Bindings = bindings
+		return nil
+	})
 }
 
-func (c *client) UpdateIamPolicy(ctx context.Context, id identifier.IamPolicyIdentifier, config iampolicy.Config, mask []value.UpdateMaskField) (iampolicy.IamPolicy, error) {
-	log.Printf("UPDATING IAM POLICY>>>>>>>>>>>")
-	switch resourceID := id.Resource.(type) {
-	case identifier.FunctionIdentifier:
-		resource := fmt.Sprintf("projects/%s/locations/%s/functions/%s", resourceID.Project, resourceID.Location, resourceID.Name)
-		getRes, err := c.CloudFunction.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
-			Resource: resource,
-		})
+// toBindingsPB converts the configured bindings. Bindings are keyed by role and condition, so bindings that share
+// both are merged into one.
+func toBindingsPB(config []iampolicy.Binding) ([]*iampb.Binding, error) {
+	var bindings []*iampb.Binding
+	byKey := map[string]*iampb.Binding{}
+	for _, b := range config {
+		role, err := iamcodec.FormatRole(b.Role)
 		if err != nil {
-			return iampolicy.IamPolicy{}, err
+			return nil, err
 		}
 
-		bindings := make([]*iampb.Binding, len(config.Bindings))
-		for i, b := range config.Bindings {
-			var roleID string
-			switch role := b.Role.(type) {
-			case identifier.IamRoleCustomProjectIdentifier:
-				roleID = fmt.Sprintf("projects/%s/roles/%s", role.Project, role.Name)
-			default:
-				return iampolicy.IamPolicy{}, fmt.Errorf("unsupported role type: %T", b.Role)
-			}
+		condition := iam.Condition(b.Condition.Title, b.Condition.Description, b.Condition.Expression)
 
-			members := make([]string, len(b.Members))
-			for i, m := range b.Members {
-				switch m := m.(type) {
-				case identifier.ServiceAccountIdentifier:
-					members[i] = fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", m.AccountId, m.Project)
-				default:
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %T", m)
-				}
+		key := iam.BindingKey(role, condition)
+		binding, ok := byKey[key]
+		if !ok {
+			binding = &iampb.Binding{
+				Role:      role,
+				Condition: condition,
 			}
+			byKey[key] = binding
+			bindings = append(bindings, binding)
+		}
 
-			bindings[i] = &iampb.Binding{
-				Role:    roleID,
-				Members: members,
+		for _, m := range b.Members {
+			member, err := iamcodec.FormatMember(m)
+			if err != nil {
+				return nil, err
 			}
+
+			binding.Members = append(binding.Members, member)
 		}
+	}
 
-		res, err := c.CloudFunction.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
-			Resource: resource,
-			Policy: &iampb.Policy{
-				Version:  3,
-				Bindings: bindings,
-				Etag:     getRes.GetEtag(),
-			},
-		})
+	return bindings, nil
+}
+
+func toIamPolicy(id identifier.IamPolicyIdentifier, res *iampb.Policy) (iampolicy.IamPolicy, error) {
+	// Bindings are ordered by their key so that a conditional binding always lines up with the same config entry.
+	sorted := make([]*iampb.Binding, len(res.Bindings))
+	copy(sorted, res.Bindings)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return iam.BindingKey(sorted[i].GetRole(), sorted[i].GetCondition()) < iam.BindingKey(sorted[j].GetRole(), sorted[j].GetCondition())
+	})
+
+	bindings := make([]iampolicy.Binding, len(sorted))
+	for i, binding := range sorted {
+		role, err := iamcodec.ParseRole(binding.Role)
 		if err != nil {
 			return iampolicy.IamPolicy{}, err
 		}
 
-		binds := make([]iampolicy.Binding, len(res.Bindings))
-		for i, binding := range res.Bindings {
-			members := make([]value.ResourceIdentifier, len(binding.Members))
-			for j, member := range binding.Members {
-				// TODO: Support "allUsers" and "allAuthenticatedUsers"
-				parts := strings.Split(member, ":")
-				if len(parts) == 0 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %s", member)
-				}
-				switch parts[0] {
-				case "serviceAccount":
-					// TODO: support default service account. Probably makes sense to create a different resource identifier type for those.
-					customServiceAccountParts := customServiceAccountRe.FindStringSubmatch(member)
-					if len(customServiceAccountParts) < 2 {
-						return iampolicy.IamPolicy{}, fmt.Errorf("invalid custom service account email: %s", member)
-					}
-
-					members[j] = identifier.ServiceAccountIdentifier{
-						AccountId: customServiceAccountParts[1],
-						Project:   customServiceAccountParts[2],
-					}
-				default:
-					return iampolicy.IamPolicy{}, fmt.Errorf("unsupported member type: %s", member)
-				}
-			}
-
-			var r value.ResourceIdentifier
-			switch {
-			case strings.HasPrefix(binding.Role, "roles/"):
-				parts := strings.Split(binding.Role, "/")
-				if len(parts) < 2 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("invalid pre-defined role: %s", binding.Role)
-				}
-
-				r = identifier.IamRoleIdentifier{
-					Name: parts[1],
-				}
-			case strings.HasPrefix(binding.Role, "projects/"):
-				parts := strings.Split(binding.Role, "/")
-				if len(parts) < 4 {
-					return iampolicy.IamPolicy{}, fmt.Errorf("invalid custom project level role: %s", binding.Role)
-				}
-				r = identifier.IamRoleCustomProjectIdentifier{
-					Project: parts[1],
-					Name:    parts[3],
-				}
+		members := make([]value.ResourceIdentifier, len(binding.Members))
+		for j, m := range binding.Members {
+			member, err := iamcodec.ParseMember(m)
+			if err != nil {
+				return iampolicy.IamPolicy{}, err
 			}
 
-			binds[i] = iampolicy.Binding{
-				Role:    r,
-				Members: members,
-			}
+			members[j] = member
 		}
 
-		return iampolicy.IamPolicy{
-			Identifier: id,
-			Config: iampolicy.Config{
-				Bindings: binds,
-			},
-			Attrs: iampolicy.Attrs{
-				Etag: fmt.Sprintf("%x", res.GetEtag()),
+		bindings[i] = iampolicy.Binding{
+			Role:    role,
+			Members: members,
+			Condition: iampolicy.Condition{
+				Title:       binding.GetCondition().GetTitle(),
+				Description: binding.GetCondition().GetDescription(),
+				Expression:  binding.GetCondition().GetExpression(),
 			},
-		}, nil
-	default:
-		return iampolicy.IamPolicy{}, fmt.Errorf("unsupported identifier type: %T", id)
-	}
-}
-
-func (c *client) DeleteIamPolicy(ctx context.Context, id identifier.IamPolicyIdentifier) error {
-	switch resourceID := id.Resource.(type) {
-	case identifier.FunctionIdentifier:
-		resource := fmt.Sprintf("projects/%s/locations/%s/functions/%s", resourceID.Project, resourceID.Location, resourceID.Name)
-		getRes, err := c.CloudFunction.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
-			Resource: resource,
-		})
-		if err != nil {
-			return err
 		}
-
-		_, err = c.CloudFunction.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
-			Resource: resource,
-			Policy: &iampb.Policy{
-				Version:  3,
-				Bindings: nil,
-				Etag:     getRes.GetEtag(),
-			},
-		})
-		return err
-	default:
-		return fmt.Errorf("invalid identifier type: %T", resourceID)
 	}
+
+	return iampolicy.IamPolicy{
+		Identifier: id,
+		Config: iampolicy.Config{
+			Bindings: bindings,
+		},
+		Attrs: iampolicy.Attrs{
+			Etag: fmt.Sprintf("%x", res.GetEtag()),
+		},
+	}, nil
 }
@@ -0,0 +1,129 @@
+package iam_role_custom_project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/iam/admin/apiv1/adminpb"
+)
+
+// permissionCatalog caches the permissions that can be tested on each project. The catalog only changes when GCP
+// releases new permissions, so it's kept for the life of the handler.
+type permissionCatalog struct {
+	mu        sync.Mutex
+	byProject map[string]map[string]adminpb.Permission_CustomRolesSupportLevel
+}
+
+// validatePermissions checks that every permission exists and can be used in a custom role on the project. All
+// problems are reported together, with a suggestion for permissions that look mistyped.
+func (c *client) validatePermissions(ctx context.Context, project string, permissions []string) error {
+	catalog, err := c.testablePermissions(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	for _, p := range permissions {
+		level, ok := catalog[p]
+		switch {
+		case !ok:
+			problem := fmt.Sprintf("%s: unknown permission", p)
+			if suggestion := closestPermission(p, catalog); suggestion != "" {
+				problem += fmt.Sprintf(", did you mean %s?", suggestion)
+			}
+			problems = append(problems, problem)
+		case level == adminpb.Permission_NOT_SUPPORTED:
+			problems = append(problems, fmt.Sprintf("%s: not supported in custom roles", p))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid permissions for custom role:\n  %s", strings.Join(problems, "\n  "))
+	}
+
+	return nil
+}
+
+func (c *client) testablePermissions(ctx context.Context, project string) (map[string]adminpb.Permission_CustomRolesSupportLevel, error) {
+	c.catalog.mu.Lock()
+	defer c.catalog.mu.Unlock()
+
+	if catalog, ok := c.catalog.byProject[project]; ok {
+		return catalog, nil
+	}
+
+	catalog := map[string]adminpb.Permission_CustomRolesSupportLevel{}
+	req := &adminpb.QueryTestablePermissionsRequest{
+		FullResourceName: fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project),
+		PageSize:         1000,
+	}
+	for {
+		res, err := c.GCP.QueryTestablePermissions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range res.GetPermissions() {
+			catalog[p.GetName()] = p.GetCustomRolesSupportLevel()
+		}
+
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+
+	if c.catalog.byProject == nil {
+		c.catalog.byProject = map[string]map[string]adminpb.Permission_CustomRolesSupportLevel{}
+	}
+	c.catalog.byProject[project] = catalog
+
+	return catalog, nil
+}
+
+// closestPermission returns the supported permission with the smallest edit distance to the given one, as long as
+// it's close enough to be a likely typo.
+func closestPermission(permission string, catalog map[string]adminpb.Permission_CustomRolesSupportLevel) string {
+	maxDistance := max(len(permission)/4, 1)
+
+	var closest string
+	closestDistance := maxDistance + 1
+	for name, level := range catalog {
+		if level == adminpb.Permission_NOT_SUPPORTED {
+			continue
+		}
+
+		d := editDistance(permission, name)
+		if d < closestDistance || (d == closestDistance && name < closest) {
+			closest = name
+			closestDistance = d
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
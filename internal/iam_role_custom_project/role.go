@@ -7,11 +7,15 @@ import (
 
 	iamrole "github.com/alchematik/athanor-provider-gcp/gen/provider/iam_role_custom_project"
 	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/customrole"
 
 	iamadmin "cloud.google.com/go/iam/admin/apiv1"
 	"cloud.google.com/go/iam/admin/apiv1/adminpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
 	"github.com/alchematik/athanor-go/sdk/provider/value"
 	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
@@ -34,6 +38,8 @@ func NewHandler(ctx context.Context) (*iamrole.IamRoleCustomProjectHandler, erro
 
 type client struct {
 	GCP GCP
+
+	catalog permissionCatalog
 }
 
 type GCP interface {
@@ -41,6 +47,8 @@ type GCP interface {
 	GetRole(context.Context, *adminpb.GetRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
 	UpdateRole(context.Context, *adminpb.UpdateRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
 	DeleteRole(context.Context, *adminpb.DeleteRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
+	UndeleteRole(context.Context, *adminpb.UndeleteRoleRequest, ...gax.CallOption) (*adminpb.Role, error)
+	QueryTestablePermissions(context.Context, *adminpb.QueryTestablePermissionsRequest, ...gax.CallOption) (*adminpb.QueryTestablePermissionsResponse, error)
 }
 
 func (c *client) GetIamRoleCustomProject(ctx context.Context, id identifier.IamRoleCustomProjectIdentifier) (iamrole.IamRoleCustomProject, error) {
@@ -48,9 +56,18 @@ func (c *client) GetIamRoleCustomProject(ctx context.Context, id identifier.IamR
 		Name: fmt.Sprintf("projects/%s/roles/%s", id.Project, id.Name),
 	})
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return iamrole.IamRoleCustomProject{}, sdkerrors.NewErrorNotFound()
+		}
+
 		return iamrole.IamRoleCustomProject{}, err
 	}
 
+	// Deleted roles are kept around for a while before they're purged, but they can't be used.
+	if res.GetDeleted() {
+		return iamrole.IamRoleCustomProject{}, sdkerrors.NewErrorNotFound()
+	}
+
 	return iamrole.IamRoleCustomProject{
 		Identifier: id,
 		Config: iamrole.Config{
@@ -67,21 +84,23 @@ func (c *client) GetIamRoleCustomProject(ctx context.Context, id identifier.IamR
 }
 
 func (c *client) CreateIamRoleCustomProject(ctx context.Context, id identifier.IamRoleCustomProjectIdentifier, config iamrole.Config) (iamrole.IamRoleCustomProject, error) {
-	stage, err := convertStage(config.Stage)
+	stage, err := customrole.ConvertStage(config.Stage)
 	if err != nil {
 		return iamrole.IamRoleCustomProject{}, err
 	}
 
-	res, err := c.GCP.CreateRole(ctx, &adminpb.CreateRoleRequest{
-		Parent: fmt.Sprintf("projects/%s", id.Project),
-		RoleId: id.Name,
-		Role: &adminpb.Role{
-			Title:               config.Title,
-			Description:         config.Description,
-			IncludedPermissions: config.Permissions,
-			Stage:               stage,
-		},
-	})
+	if err := c.validatePermissions(ctx, id.Project, config.Permissions); err != nil {
+		return iamrole.IamRoleCustomProject{}, err
+	}
+
+	role := &adminpb.Role{
+		Title:               config.Title,
+		Description:         config.Description,
+		IncludedPermissions: config.Permissions,
+		Stage:               stage,
+	}
+
+	res, err := customrole.Create(ctx, c.GCP, fmt.Sprintf("projects/%s", id.Project), id.Name, role)
 	if err != nil {
 		return iamrole.IamRoleCustomProject{}, err
 	}
@@ -115,13 +134,16 @@ func (c *client) UpdateIamRoleCustomProject(ctx context.Context, id identifier.I
 			r.Description = config.Description
 			updateMask.Paths = append(updateMask.Paths, "description")
 		case "stage":
-			stage, err := convertStage(config.Stage)
+			stage, err := customrole.ConvertStage(config.Stage)
 			if err != nil {
 				return iamrole.IamRoleCustomProject{}, err
 			}
 			r.Stage = stage
 			updateMask.Paths = append(updateMask.Paths, "stage")
 		case "permissions":
+			if err := c.validatePermissions(ctx, id.Project, config.Permissions); err != nil {
+				return iamrole.IamRoleCustomProject{}, err
+			}
 			r.IncludedPermissions = config.Permissions
 			updateMask.Paths = append(updateMask.Paths, "included_permissions")
 		}
@@ -162,22 +184,3 @@ func (c *client) DeleteIamRoleCustomProject(ctx context.Context, id identifier.I
 	})
 	return err
 }
-
-func convertStage(str string) (adminpb.Role_RoleLaunchStage, error) {
-	switch str {
-	case "ALPHA":
-		return adminpb.Role_ALPHA, nil
-	case "BETA":
-		return adminpb.Role_BETA, nil
-	case "GA":
-		return adminpb.Role_GA, nil
-	case "DEPRECATED":
-		return adminpb.Role_DEPRECATED, nil
-	case "DISABLED":
-		return adminpb.Role_DISABLED, nil
-	case "EAP":
-		return adminpb.Role_EAP, nil
-	default:
-		return 0, fmt.Errorf("invalid role launch stage: %s", str)
-	}
-}
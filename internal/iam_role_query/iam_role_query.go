@@ -0,0 +1,136 @@
+package iam_role_query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	iamrolequery "github.com/alchematik/athanor-provider-gcp/gen/provider/iam_role_query"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/internal/iam"
+
+	iamadmin "cloud.google.com/go/iam/admin/apiv1"
+	"cloud.google.com/go/iam/admin/apiv1/adminpb"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+)
+
+func NewHandler(ctx context.Context) (*iamrolequery.IamRoleQueryHandler, error) {
+	gcp, err := iamadmin.NewIamClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+
+	return &iamrolequery.IamRoleQueryHandler{
+		IamRoleQueryGetter: c,
+		CloseFunc:          gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	QueryGrantableRoles(context.Context, *adminpb.QueryGrantableRolesRequest, ...gax.CallOption) (*adminpb.QueryGrantableRolesResponse, error)
+}
+
+// GetIamRoleQuery finds the predefined roles that can be granted on the resource and match the query. A role
+// matches when it includes all of the permissions and its title contains the title filter. Roles are ranked by
+// how many permissions they grant beyond the ones asked for, so the first role is the least privileged.
+func (c *client) GetIamRoleQuery(ctx context.Context, id identifier.IamRoleQueryIdentifier) (iamrolequery.IamRoleQuery, error) {
+	if len(id.Permissions) == 0 && id.Title == "" {
+		return iamrolequery.IamRoleQuery{}, fmt.Errorf("at least one of permissions or title must be set")
+	}
+
+	resource, err := iam.FullResourceName(id.Resource)
+	if err != nil {
+		return iamrolequery.IamRoleQuery{}, err
+	}
+
+	type match struct {
+		name  string
+		extra int
+	}
+
+	var matches []match
+	req := &adminpb.QueryGrantableRolesRequest{
+		FullResourceName: resource,
+		View:             adminpb.RoleView_FULL,
+		PageSize:         1000,
+	}
+	for {
+		res, err := c.GCP.QueryGrantableRoles(ctx, req)
+		if err != nil {
+			return iamrolequery.IamRoleQuery{}, err
+		}
+
+		for _, r := range res.GetRoles() {
+			// Custom roles are also grantable, but only predefined roles are of interest.
+			if !strings.HasPrefix(r.GetName(), "roles/") || r.GetDeleted() || r.GetStage() == adminpb.Role_DEPRECATED {
+				continue
+			}
+
+			if id.Title != "" && !strings.Contains(strings.ToLower(r.GetTitle()), strings.ToLower(id.Title)) {
+				continue
+			}
+
+			if !includesAll(r.GetIncludedPermissions(), id.Permissions) {
+				continue
+			}
+
+			matches = append(matches, match{
+				name:  strings.TrimPrefix(r.GetName(), "roles/"),
+				extra: len(r.GetIncludedPermissions()) - len(id.Permissions),
+			})
+		}
+
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].extra != matches[j].extra {
+			return matches[i].extra < matches[j].extra
+		}
+
+		return matches[i].name < matches[j].name
+	})
+
+	roles := make([]value.ResourceIdentifier, len(matches))
+	for i, m := range matches {
+		roles[i] = identifier.IamRoleIdentifier{
+			Name: m.name,
+		}
+	}
+
+	return iamrolequery.IamRoleQuery{
+		Identifier: id,
+		Config:     iamrolequery.Config{},
+		Attrs: iamrolequery.Attrs{
+			Roles: roles,
+		},
+	}, nil
+}
+
+func includesAll(included, wanted []string) bool {
+	set := make(map[string]bool, len(included))
+	for _, p := range included {
+		set[p] = true
+	}
+
+	for _, p := range wanted {
+		if !set[p] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+package iamcodec
+
+import (
+	"fmt"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+// UnknownFormatError is returned when a member or role string from a policy isn't in a format GCP documents.
+type UnknownFormatError struct {
+	// Kind is one of "member", "role" or "service account".
+	Kind  string
+	Value string
+}
+
+func (e *UnknownFormatError) Error() string {
+	return fmt.Sprintf("unknown %s format: %q", e.Kind, e.Value)
+}
+
+// UnsupportedIdentifierError is returned when an identifier can't be used as a member or role.
+type UnsupportedIdentifierError struct {
+	// Kind is one of "member", "role" or "service account".
+	Kind       string
+	Identifier value.ResourceIdentifier
+}
+
+func (e *UnsupportedIdentifierError) Error() string {
+	return fmt.Sprintf("unsupported %s type: %T", e.Kind, e.Identifier)
+}
+
+// InvalidIdentifierError is returned when an identifier of a supported type has a field that can't be encoded.
+type InvalidIdentifierError struct {
+	Field string
+	Value string
+}
+
+func (e *InvalidIdentifierError) Error() string {
+	return fmt.Sprintf("invalid value for %s: %q", e.Field, e.Value)
+}
@@ -0,0 +1,129 @@
+package iamcodec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+var (
+	customServiceAccountRe = regexp.MustCompile(`^(.+)@(.+)\.iam\.gserviceaccount\.com$`)
+	deletedMemberRe        = regexp.MustCompile(`^deleted:((?:user|group|serviceAccount):.+)\?uid=(.+)$`)
+)
+
+var projectRolePrefixes = map[string]string{
+	"owner":  "projectOwner",
+	"editor": "projectEditor",
+	"viewer": "projectViewer",
+}
+
+// FormatMember returns the principal that policies refer to the member by.
+func FormatMember(member value.ResourceIdentifier) (string, error) {
+	switch m := member.(type) {
+	case identifier.ServiceAccountIdentifier:
+		return fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", m.AccountId, m.Project), nil
+	case identifier.IamMemberGoogleServiceAccountIdentifier:
+		return fmt.Sprintf("serviceAccount:%s", m.Email), nil
+	case identifier.IamMemberUserIdentifier:
+		return fmt.Sprintf("user:%s", m.Email), nil
+	case identifier.IamMemberGroupIdentifier:
+		return fmt.Sprintf("group:%s", m.Email), nil
+	case identifier.IamMemberDomainIdentifier:
+		return fmt.Sprintf("domain:%s", m.Domain), nil
+	case identifier.IamMemberPublicIdentifier:
+		if m.Principal != "allUsers" && m.Principal != "allAuthenticatedUsers" {
+			return "", &InvalidIdentifierError{Field: "principal", Value: m.Principal}
+		}
+
+		return m.Principal, nil
+	case identifier.IamMemberProjectRoleIdentifier:
+		prefix, ok := projectRolePrefixes[m.Role]
+		if !ok {
+			return "", &InvalidIdentifierError{Field: "role", Value: m.Role}
+		}
+
+		return fmt.Sprintf("%s:%s", prefix, m.Project), nil
+	case identifier.IamMemberPrincipalIdentifier:
+		if !strings.HasPrefix(m.Uri, "principal://") && !strings.HasPrefix(m.Uri, "principalSet://") {
+			return "", &InvalidIdentifierError{Field: "uri", Value: m.Uri}
+		}
+
+		return m.Uri, nil
+	case identifier.IamMemberDeletedIdentifier:
+		return fmt.Sprintf("deleted:%s?uid=%s", m.Member, m.Uid), nil
+	default:
+		return "", &UnsupportedIdentifierError{Kind: "member", Identifier: member}
+	}
+}
+
+// ParseMember is the inverse of FormatMember.
+func ParseMember(member string) (value.ResourceIdentifier, error) {
+	switch {
+	case member == "allUsers", member == "allAuthenticatedUsers":
+		return identifier.IamMemberPublicIdentifier{
+			Principal: member,
+		}, nil
+	case strings.HasPrefix(member, "principal://"), strings.HasPrefix(member, "principalSet://"):
+		return identifier.IamMemberPrincipalIdentifier{
+			Uri: member,
+		}, nil
+	case strings.HasPrefix(member, "deleted:"):
+		matches := deletedMemberRe.FindStringSubmatch(member)
+		if len(matches) < 3 {
+			return nil, &UnknownFormatError{Kind: "member", Value: member}
+		}
+
+		return identifier.IamMemberDeletedIdentifier{
+			Member: matches[1],
+			Uid:    matches[2],
+		}, nil
+	}
+
+	kind, principal, ok := strings.Cut(member, ":")
+	if !ok || principal == "" {
+		return nil, &UnknownFormatError{Kind: "member", Value: member}
+	}
+
+	switch kind {
+	case "serviceAccount":
+		// Service agents look like user-managed accounts, but live in Google-owned gcp-sa-* projects.
+		matches := customServiceAccountRe.FindStringSubmatch(principal)
+		if len(matches) < 3 || strings.HasPrefix(matches[2], "gcp-sa-") {
+			return identifier.IamMemberGoogleServiceAccountIdentifier{
+				Email: principal,
+			}, nil
+		}
+
+		return identifier.ServiceAccountIdentifier{
+			AccountId: matches[1],
+			Project:   matches[2],
+		}, nil
+	case "user":
+		return identifier.IamMemberUserIdentifier{
+			Email: principal,
+		}, nil
+	case "group":
+		return identifier.IamMemberGroupIdentifier{
+			Email: principal,
+		}, nil
+	case "domain":
+		return identifier.IamMemberDomainIdentifier{
+			Domain: principal,
+		}, nil
+	}
+
+	for role, prefix := range projectRolePrefixes {
+		if kind == prefix {
+			return identifier.IamMemberProjectRoleIdentifier{
+				Project: principal,
+				Role:    role,
+			}, nil
+		}
+	}
+
+	return nil, &UnknownFormatError{Kind: "member", Value: member}
+}
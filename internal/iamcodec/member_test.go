@@ -0,0 +1,184 @@
+package iamcodec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+func TestMemberRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		member string
+		id     value.ResourceIdentifier
+	}{
+		{
+			name:   "service account",
+			member: "serviceAccount:deployer@my-project.iam.gserviceaccount.com",
+			id:     identifier.ServiceAccountIdentifier{AccountId: "deployer", Project: "my-project"},
+		},
+		{
+			name:   "service agent",
+			member: "serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com",
+			id:     identifier.IamMemberGoogleServiceAccountIdentifier{Email: "service-123@gcp-sa-pubsub.iam.gserviceaccount.com"},
+		},
+		{
+			name:   "compute engine default service account",
+			member: "serviceAccount:123-compute@developer.gserviceaccount.com",
+			id:     identifier.IamMemberGoogleServiceAccountIdentifier{Email: "123-compute@developer.gserviceaccount.com"},
+		},
+		{
+			name:   "app engine default service account",
+			member: "serviceAccount:my-project@appspot.gserviceaccount.com",
+			id:     identifier.IamMemberGoogleServiceAccountIdentifier{Email: "my-project@appspot.gserviceaccount.com"},
+		},
+		{
+			name:   "user",
+			member: "user:alice@example.com",
+			id:     identifier.IamMemberUserIdentifier{Email: "alice@example.com"},
+		},
+		{
+			name:   "group",
+			member: "group:admins@example.com",
+			id:     identifier.IamMemberGroupIdentifier{Email: "admins@example.com"},
+		},
+		{
+			name:   "domain",
+			member: "domain:example.com",
+			id:     identifier.IamMemberDomainIdentifier{Domain: "example.com"},
+		},
+		{
+			name:   "all users",
+			member: "allUsers",
+			id:     identifier.IamMemberPublicIdentifier{Principal: "allUsers"},
+		},
+		{
+			name:   "all authenticated users",
+			member: "allAuthenticatedUsers",
+			id:     identifier.IamMemberPublicIdentifier{Principal: "allAuthenticatedUsers"},
+		},
+		{
+			name:   "project owners",
+			member: "projectOwner:my-project",
+			id:     identifier.IamMemberProjectRoleIdentifier{Project: "my-project", Role: "owner"},
+		},
+		{
+			name:   "project editors",
+			member: "projectEditor:my-project",
+			id:     identifier.IamMemberProjectRoleIdentifier{Project: "my-project", Role: "editor"},
+		},
+		{
+			name:   "project viewers",
+			member: "projectViewer:my-project",
+			id:     identifier.IamMemberProjectRoleIdentifier{Project: "my-project", Role: "viewer"},
+		},
+		{
+			name:   "principal",
+			member: "principal://iam.googleapis.com/locations/global/workforcePools/my-pool/subject/alice",
+			id:     identifier.IamMemberPrincipalIdentifier{Uri: "principal://iam.googleapis.com/locations/global/workforcePools/my-pool/subject/alice"},
+		},
+		{
+			name:   "principal set",
+			member: "principalSet://iam.googleapis.com/locations/global/workforcePools/my-pool/*",
+			id:     identifier.IamMemberPrincipalIdentifier{Uri: "principalSet://iam.googleapis.com/locations/global/workforcePools/my-pool/*"},
+		},
+		{
+			name:   "deleted user",
+			member: "deleted:user:alice@example.com?uid=123456789",
+			id:     identifier.IamMemberDeletedIdentifier{Member: "user:alice@example.com", Uid: "123456789"},
+		},
+		{
+			name:   "deleted service account",
+			member: "deleted:serviceAccount:deployer@my-project.iam.gserviceaccount.com?uid=123456789",
+			id:     identifier.IamMemberDeletedIdentifier{Member: "serviceAccount:deployer@my-project.iam.gserviceaccount.com", Uid: "123456789"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseMember(tt.member)
+			if err != nil {
+				t.Fatalf("ParseMember(%q) returned error: %v", tt.member, err)
+			}
+			if id != tt.id {
+				t.Errorf("ParseMember(%q) = %#v, want %#v", tt.member, id, tt.id)
+			}
+
+			member, err := FormatMember(tt.id)
+			if err != nil {
+				t.Fatalf("FormatMember(%#v) returned error: %v", tt.id, err)
+			}
+			if member != tt.member {
+				t.Errorf("FormatMember(%#v) = %q, want %q", tt.id, member, tt.member)
+			}
+		})
+	}
+}
+
+func TestParseMemberErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		member string
+	}{
+		{name: "empty", member: ""},
+		{name: "no prefix", member: "alice@example.com"},
+		{name: "unknown prefix", member: "robot:alice@example.com"},
+		{name: "empty principal", member: "user:"},
+		{name: "deleted without uid", member: "deleted:user:alice@example.com"},
+		{name: "deleted with empty uid", member: "deleted:user:alice@example.com?uid="},
+		{name: "deleted domain", member: "deleted:domain:example.com?uid=123456789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseMember(tt.member)
+
+			var formatErr *UnknownFormatError
+			if !errors.As(err, &formatErr) {
+				t.Fatalf("ParseMember(%q) returned %v, want an UnknownFormatError", tt.member, err)
+			}
+		})
+	}
+}
+
+func TestFormatMemberErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		id   value.ResourceIdentifier
+		// target is passed to errors.As.
+		target any
+	}{
+		{
+			name:   "unknown public principal",
+			id:     identifier.IamMemberPublicIdentifier{Principal: "everyone"},
+			target: new(*InvalidIdentifierError),
+		},
+		{
+			name:   "unknown project role",
+			id:     identifier.IamMemberProjectRoleIdentifier{Project: "my-project", Role: "admin"},
+			target: new(*InvalidIdentifierError),
+		},
+		{
+			name:   "principal without scheme",
+			id:     identifier.IamMemberPrincipalIdentifier{Uri: "iam.googleapis.com/locations/global/workforcePools/my-pool/*"},
+			target: new(*InvalidIdentifierError),
+		},
+		{
+			name:   "role",
+			id:     identifier.IamRoleIdentifier{Name: "viewer"},
+			target: new(*UnsupportedIdentifierError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FormatMember(tt.id)
+			if !errors.As(err, tt.target) {
+				t.Fatalf("FormatMember(%#v) returned %v, want a %T", tt.id, err, tt.target)
+			}
+		})
+	}
+}
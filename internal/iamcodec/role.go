@@ -0,0 +1,53 @@
+package iamcodec
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+// FormatRole returns the name that policies refer to the role by.
+func FormatRole(role value.ResourceIdentifier) (string, error) {
+	switch r := role.(type) {
+	case identifier.IamRoleIdentifier:
+		return fmt.Sprintf("roles/%s", r.Name), nil
+	case identifier.IamRoleCustomProjectIdentifier:
+		return fmt.Sprintf("projects/%s/roles/%s", r.Project, r.Name), nil
+	case identifier.IamRoleCustomOrganizationIdentifier:
+		return fmt.Sprintf("organizations/%s/roles/%s", r.Organization, r.Name), nil
+	default:
+		return "", &UnsupportedIdentifierError{Kind: "role", Identifier: role}
+	}
+}
+
+// ParseRole is the inverse of FormatRole. Roles are either predefined (roles/<name>) or custom roles of a
+// project (projects/<project>/roles/<name>) or organization (organizations/<id>/roles/<name>).
+func ParseRole(role string) (value.ResourceIdentifier, error) {
+	parts := strings.Split(role, "/")
+	if slices.Contains(parts, "") {
+		return nil, &UnknownFormatError{Kind: "role", Value: role}
+	}
+
+	switch {
+	case len(parts) == 2 && parts[0] == "roles":
+		return identifier.IamRoleIdentifier{
+			Name: parts[1],
+		}, nil
+	case len(parts) == 4 && parts[0] == "projects" && parts[2] == "roles":
+		return identifier.IamRoleCustomProjectIdentifier{
+			Project: parts[1],
+			Name:    parts[3],
+		}, nil
+	case len(parts) == 4 && parts[0] == "organizations" && parts[2] == "roles":
+		return identifier.IamRoleCustomOrganizationIdentifier{
+			Organization: parts[1],
+			Name:         parts[3],
+		}, nil
+	default:
+		return nil, &UnknownFormatError{Kind: "role", Value: role}
+	}
+}
@@ -0,0 +1,99 @@
+package iamcodec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+func TestRoleRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		role string
+		id   value.ResourceIdentifier
+	}{
+		{
+			name: "predefined",
+			role: "roles/storage.objectViewer",
+			id:   identifier.IamRoleIdentifier{Name: "storage.objectViewer"},
+		},
+		{
+			name: "basic",
+			role: "roles/owner",
+			id:   identifier.IamRoleIdentifier{Name: "owner"},
+		},
+		{
+			name: "custom project role",
+			role: "projects/my-project/roles/deployer",
+			id:   identifier.IamRoleCustomProjectIdentifier{Project: "my-project", Name: "deployer"},
+		},
+		{
+			name: "custom organization role",
+			role: "organizations/123456789/roles/auditor",
+			id:   identifier.IamRoleCustomOrganizationIdentifier{Organization: "123456789", Name: "auditor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseRole(tt.role)
+			if err != nil {
+				t.Fatalf("ParseRole(%q) returned error: %v", tt.role, err)
+			}
+			if id != tt.id {
+				t.Errorf("ParseRole(%q) = %#v, want %#v", tt.role, id, tt.id)
+			}
+
+			role, err := FormatRole(tt.id)
+			if err != nil {
+				t.Fatalf("FormatRole(%#v) returned error: %v", tt.id, err)
+			}
+			if role != tt.role {
+				t.Errorf("FormatRole(%#v) = %q, want %q", tt.id, role, tt.role)
+			}
+		})
+	}
+}
+
+func TestParseRoleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		role string
+	}{
+		{name: "empty", role: ""},
+		{name: "no prefix", role: "owner"},
+		{name: "empty predefined name", role: "roles/"},
+		{name: "nested predefined name", role: "roles/storage/admin"},
+		{name: "project without name", role: "projects/my-project/roles/"},
+		{name: "project without project", role: "projects//roles/deployer"},
+		{name: "project without roles", role: "projects/my-project/deployer"},
+		{name: "organization without name", role: "organizations/123456789/roles/"},
+		{name: "organization without roles", role: "organizations/123456789/custom/auditor"},
+		{name: "unknown prefix", role: "folders/123456789/roles/auditor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRole(tt.role)
+
+			var formatErr *UnknownFormatError
+			if !errors.As(err, &formatErr) {
+				t.Fatalf("ParseRole(%q) returned %v, want an UnknownFormatError", tt.role, err)
+			}
+		})
+	}
+}
+
+func TestFormatRoleErrors(t *testing.T) {
+	id := identifier.IamMemberUserIdentifier{Email: "alice@example.com"}
+
+	_, err := FormatRole(id)
+
+	var unsupportedErr *UnsupportedIdentifierError
+	if !errors.As(err, &unsupportedErr) {
+		t.Fatalf("FormatRole(%#v) returned %v, want an UnsupportedIdentifierError", id, err)
+	}
+}
@@ -0,0 +1,70 @@
+package iamcodec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+// computeDefaultServiceAccountRe matches the Compute Engine default service account of a project, which is what
+// Cloud Run and Cloud Functions run as when no service account is set.
+var computeDefaultServiceAccountRe = regexp.MustCompile(`^[0-9]+-compute@developer\.gserviceaccount\.com$`)
+
+// FormatServiceAccountEmail returns the email of a service_account or iam_member_google_service_account identifier.
+func FormatServiceAccountEmail(account value.ResourceIdentifier) (string, error) {
+	switch a := account.(type) {
+	case identifier.ServiceAccountIdentifier, identifier.IamMemberGoogleServiceAccountIdentifier:
+		member, err := FormatMember(a)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimPrefix(member, "serviceAccount:"), nil
+	default:
+		return "", &UnsupportedIdentifierError{Kind: "service account", Identifier: account}
+	}
+}
+
+// ParseServiceAccountEmail is the inverse of FormatServiceAccountEmail. Accounts outside of
+// iam.gserviceaccount.com, such as the Compute Engine default service account, are returned as
+// iam_member_google_service_account identifiers.
+func ParseServiceAccountEmail(email string) (value.ResourceIdentifier, error) {
+	if !strings.Contains(email, "@") {
+		return nil, &UnknownFormatError{Kind: "service account", Value: email}
+	}
+
+	return ParseMember(fmt.Sprintf("serviceAccount:%s", email))
+}
+
+// FormatRuntimeServiceAccountEmail is like FormatServiceAccountEmail, but formats nil as an empty string, which has
+// GCP run the workload as the Compute Engine default service account. Since that account is read back as nil, it
+// can't be set explicitly.
+func FormatRuntimeServiceAccountEmail(account value.ResourceIdentifier) (string, error) {
+	if account == nil {
+		return "", nil
+	}
+
+	email, err := FormatServiceAccountEmail(account)
+	if err != nil {
+		return "", err
+	}
+
+	if computeDefaultServiceAccountRe.MatchString(email) {
+		return "", fmt.Errorf("%s is the default service account, leave it empty instead", email)
+	}
+
+	return email, nil
+}
+
+// ParseRuntimeServiceAccountEmail is the inverse of FormatRuntimeServiceAccountEmail.
+func ParseRuntimeServiceAccountEmail(email string) (value.ResourceIdentifier, error) {
+	if email == "" || computeDefaultServiceAccountRe.MatchString(email) {
+		return nil, nil
+	}
+
+	return ParseServiceAccountEmail(email)
+}
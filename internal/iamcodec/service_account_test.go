@@ -0,0 +1,70 @@
+package iamcodec
+
+import (
+	"testing"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+)
+
+func TestRuntimeServiceAccountEmailRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		id    value.ResourceIdentifier
+	}{
+		{
+			name:  "default",
+			email: "",
+			id:    nil,
+		},
+		{
+			name:  "service account",
+			email: "deployer@my-project.iam.gserviceaccount.com",
+			id:    identifier.ServiceAccountIdentifier{AccountId: "deployer", Project: "my-project"},
+		},
+		{
+			name:  "app engine default service account",
+			email: "my-project@appspot.gserviceaccount.com",
+			id:    identifier.IamMemberGoogleServiceAccountIdentifier{Email: "my-project@appspot.gserviceaccount.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseRuntimeServiceAccountEmail(tt.email)
+			if err != nil {
+				t.Fatalf("ParseRuntimeServiceAccountEmail(%q) returned error: %v", tt.email, err)
+			}
+			if id != tt.id {
+				t.Errorf("ParseRuntimeServiceAccountEmail(%q) = %#v, want %#v", tt.email, id, tt.id)
+			}
+
+			email, err := FormatRuntimeServiceAccountEmail(tt.id)
+			if err != nil {
+				t.Fatalf("FormatRuntimeServiceAccountEmail(%#v) returned error: %v", tt.id, err)
+			}
+			if email != tt.email {
+				t.Errorf("FormatRuntimeServiceAccountEmail(%#v) = %q, want %q", tt.id, email, tt.email)
+			}
+		})
+	}
+}
+
+func TestRuntimeServiceAccountEmailComputeDefault(t *testing.T) {
+	email := "123-compute@developer.gserviceaccount.com"
+
+	id, err := ParseRuntimeServiceAccountEmail(email)
+	if err != nil {
+		t.Fatalf("ParseRuntimeServiceAccountEmail(%q) returned error: %v", email, err)
+	}
+	if id != nil {
+		t.Errorf("ParseRuntimeServiceAccountEmail(%q) = %#v, want nil", email, id)
+	}
+
+	account := identifier.IamMemberGoogleServiceAccountIdentifier{Email: email}
+	if _, err := FormatRuntimeServiceAccountEmail(account); err == nil {
+		t.Errorf("FormatRuntimeServiceAccountEmail(%#v) returned no error", account)
+	}
+}
@@ -0,0 +1,66 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/project"
+
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"cloud.google.com/go/resourcemanager/apiv3/resourcemanagerpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func NewHandler(ctx context.Context) (*project.ProjectHandler, error) {
+	gcp, err := resourcemanager.NewProjectsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &project.ProjectHandler{
+		ProjectGetter: c,
+		CloseFunc:     gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetProject(context.Context, *resourcemanagerpb.GetProjectRequest, ...gax.CallOption) (*resourcemanagerpb.Project, error)
+}
+
+func (c *client) GetProject(ctx context.Context, id identifier.ProjectIdentifier) (project.Project, error) {
+	res, err := c.GCP.GetProject(ctx, &resourcemanagerpb.GetProjectRequest{
+		Name: fmt.Sprintf("projects/%s", id.ProjectId),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return project.Project{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return project.Project{}, err
+	}
+
+	return project.Project{
+		Identifier: id,
+		Config:     project.Config{},
+		Attrs: project.Attrs{
+			// Has the form of projects/<project number>
+			ProjectNumber: strings.TrimPrefix(res.GetName(), "projects/"),
+			DisplayName:   res.GetDisplayName(),
+			Parent:        res.GetParent(),
+			State:         res.GetState().String(),
+			Etag:          res.GetEtag(),
+		},
+	}, nil
+}
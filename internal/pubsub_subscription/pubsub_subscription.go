@@ -0,0 +1,290 @@
+package pubsub_subscription
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	pubsubsubscription "github.com/alchematik/athanor-provider-gcp/gen/provider/pubsub_subscription"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+
+	pubsub "cloud.google.com/go/pubsub/apiv1"
+	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	topicRe = regexp.MustCompile(`projects\/(.+)\/topics\/(.+)`)
+)
+
+// GCP reports these values back when the fields are left empty.
+var (
+	ackDeadlineField         = fieldcodec.Int32{Name: "ack_deadline_seconds", Default: 10}
+	maxDeliveryAttemptsField = fieldcodec.Int32{Name: "dead_letter_policy.max_delivery_attempts", Default: 5}
+	minimumBackoffField      = fieldcodec.Duration{Name: "retry_policy.minimum_backoff", Default: 10 * time.Second}
+	maximumBackoffField      = fieldcodec.Duration{Name: "retry_policy.maximum_backoff", Default: 10 * time.Minute}
+)
+
+func NewHandler(ctx context.Context) (*pubsubsubscription.PubsubSubscriptionHandler, error) {
+	gcp, err := pubsub.NewSubscriberClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &pubsubsubscription.PubsubSubscriptionHandler{
+		PubsubSubscriptionGetter:  c,
+		PubsubSubscriptionCreator: c,
+		PubsubSubscriptionUpdator: c,
+		PubsubSubscriptionDeleter: c,
+		CloseFunc:                 gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetSubscription(context.Context, *pubsubpb.GetSubscriptionRequest, ...gax.CallOption) (*pubsubpb.Subscription, error)
+	CreateSubscription(context.Context, *pubsubpb.Subscription, ...gax.CallOption) (*pubsubpb.Subscription, error)
+	UpdateSubscription(context.Context, *pubsubpb.UpdateSubscriptionRequest, ...gax.CallOption) (*pubsubpb.Subscription, error)
+	DeleteSubscription(context.Context, *pubsubpb.DeleteSubscriptionRequest, ...gax.CallOption) error
+}
+
+func (c *client) GetPubsubSubscription(ctx context.Context, id identifier.PubsubSubscriptionIdentifier) (pubsubsubscription.PubsubSubscription, error) {
+	topicID, ok := id.Topic.(identifier.PubsubTopicIdentifier)
+	if !ok {
+		return pubsubsubscription.PubsubSubscription{}, fmt.Errorf("field topic must be a pubsub_topic identifier")
+	}
+
+	res, err := c.GCP.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{
+		Subscription: fmt.Sprintf("projects/%s/subscriptions/%s", topicID.Project, id.Name),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return pubsubsubscription.PubsubSubscription{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return pubsubsubscription.PubsubSubscription{}, err
+	}
+
+	return toPubsubSubscription(id, res)
+}
+
+func (c *client) CreatePubsubSubscription(ctx context.Context, id identifier.PubsubSubscriptionIdentifier, config pubsubsubscription.Config) (pubsubsubscription.PubsubSubscription, error) {
+	topicID, ok := id.Topic.(identifier.PubsubTopicIdentifier)
+	if !ok {
+		return pubsubsubscription.PubsubSubscription{}, fmt.Errorf("field topic must be a pubsub_topic identifier")
+	}
+
+	ackDeadline, err := ackDeadlineField.Parse(config.AckDeadlineSeconds)
+	if err != nil {
+		return pubsubsubscription.PubsubSubscription{}, err
+	}
+
+	deadLetterPolicy, err := toDeadLetterPolicyPB(config.DeadLetterPolicy)
+	if err != nil {
+		return pubsubsubscription.PubsubSubscription{}, err
+	}
+
+	retryPolicy, err := toRetryPolicyPB(config.RetryPolicy)
+	if err != nil {
+		return pubsubsubscription.PubsubSubscription{}, err
+	}
+
+	res, err := c.GCP.CreateSubscription(ctx, &pubsubpb.Subscription{
+		Name:                      fmt.Sprintf("projects/%s/subscriptions/%s", topicID.Project, id.Name),
+		Topic:                     fmt.Sprintf("projects/%s/topics/%s", topicID.Project, topicID.Name),
+		Labels:                    config.Labels,
+		AckDeadlineSeconds:        ackDeadline,
+		PushConfig:                toPushConfigPB(config.PushEndpoint),
+		DeadLetterPolicy:          deadLetterPolicy,
+		RetryPolicy:               retryPolicy,
+		Filter:                    config.Filter,
+		EnableExactlyOnceDelivery: config.EnableExactlyOnceDelivery,
+	})
+	if err != nil {
+		return pubsubsubscription.PubsubSubscription{}, err
+	}
+
+	return toPubsubSubscription(id, res)
+}
+
+func (c *client) UpdatePubsubSubscription(ctx context.Context, id identifier.PubsubSubscriptionIdentifier, config pubsubsubscription.Config, mask []value.UpdateMaskField) (pubsubsubscription.PubsubSubscription, error) {
+	topicID, ok := id.Topic.(identifier.PubsubTopicIdentifier)
+	if !ok {
+		return pubsubsubscription.PubsubSubscription{}, fmt.Errorf("field topic must be a pubsub_topic identifier")
+	}
+
+	updateMask := &fieldmaskpb.FieldMask{}
+	sub := &pubsubpb.Subscription{
+		Name: fmt.Sprintf("projects/%s/subscriptions/%s", topicID.Project, id.Name),
+	}
+
+	for _, m := range mask {
+		switch m.Name {
+		case "labels":
+			sub.Labels = config.Labels
+			updateMask.Paths = append(updateMask.Paths, "labels")
+		case "ack_deadline_seconds":
+			ackDeadline, err := ackDeadlineField.Parse(config.AckDeadlineSeconds)
+			if err != nil {
+				return pubsubsubscription.PubsubSubscription{}, err
+			}
+			sub.AckDeadlineSeconds = ackDeadline
+			updateMask.Paths = append(updateMask.Paths, "ack_deadline_seconds")
+		case "push_endpoint":
+			// An empty push config turns the subscription back into a pull subscription.
+			sub.PushConfig = toPushConfigPB(config.PushEndpoint)
+			if sub.PushConfig == nil {
+				sub.PushConfig = &pubsubpb.PushConfig{}
+			}
+			updateMask.Paths = append(updateMask.Paths, "push_config")
+		case "dead_letter_policy":
+			deadLetterPolicy, err := toDeadLetterPolicyPB(config.DeadLetterPolicy)
+			if err != nil {
+				return pubsubsubscription.PubsubSubscription{}, err
+			}
+			sub.DeadLetterPolicy = deadLetterPolicy
+			updateMask.Paths = append(updateMask.Paths, "dead_letter_policy")
+		case "retry_policy":
+			retryPolicy, err := toRetryPolicyPB(config.RetryPolicy)
+			if err != nil {
+				return pubsubsubscription.PubsubSubscription{}, err
+			}
+			sub.RetryPolicy = retryPolicy
+			updateMask.Paths = append(updateMask.Paths, "retry_policy")
+		case "enable_exactly_once_delivery":
+			sub.EnableExactlyOnceDelivery = config.EnableExactlyOnceDelivery
+			updateMask.Paths = append(updateMask.Paths, "enable_exactly_once_delivery")
+		}
+	}
+
+	res, err := c.GCP.UpdateSubscription(ctx, &pubsubpb.UpdateSubscriptionRequest{
+		Subscription: sub,
+		UpdateMask:   updateMask,
+	})
+	if err != nil {
+		return pubsubsubscription.PubsubSubscription{}, err
+	}
+
+	return toPubsubSubscription(id, res)
+}
+
+func (c *client) DeletePubsubSubscription(ctx context.Context, id identifier.PubsubSubscriptionIdentifier) error {
+	topicID, ok := id.Topic.(identifier.PubsubTopicIdentifier)
+	if !ok {
+		return fmt.Errorf("field topic must be a pubsub_topic identifier")
+	}
+
+	return c.GCP.DeleteSubscription(ctx, &pubsubpb.DeleteSubscriptionRequest{
+		Subscription: fmt.Sprintf("projects/%s/subscriptions/%s", topicID.Project, id.Name),
+	})
+}
+
+func toPushConfigPB(endpoint string) *pubsubpb.PushConfig {
+	if endpoint == "" {
+		return nil
+	}
+
+	return &pubsubpb.PushConfig{
+		PushEndpoint: endpoint,
+	}
+}
+
+func toDeadLetterPolicyPB(policies []pubsubsubscription.DeadLetterPolicy) (*pubsubpb.DeadLetterPolicy, error) {
+	switch len(policies) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("at most one dead_letter_policy can be set, got %d", len(policies))
+	}
+
+	policy := policies[0]
+	topicID, ok := policy.DeadLetterTopic.(identifier.PubsubTopicIdentifier)
+	if !ok {
+		return nil, fmt.Errorf("field dead_letter_topic must be a pubsub_topic identifier")
+	}
+
+	maxAttempts, err := maxDeliveryAttemptsField.Parse(policy.MaxDeliveryAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pubsubpb.DeadLetterPolicy{
+		DeadLetterTopic:     fmt.Sprintf("projects/%s/topics/%s", topicID.Project, topicID.Name),
+		MaxDeliveryAttempts: maxAttempts,
+	}, nil
+}
+
+func toRetryPolicyPB(policy pubsubsubscription.RetryPolicy) (*pubsubpb.RetryPolicy, error) {
+	if policy.MinimumBackoff == "" && policy.MaximumBackoff == "" {
+		return nil, nil
+	}
+
+	minBackoff, err := minimumBackoffField.ParseProto(policy.MinimumBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	maxBackoff, err := maximumBackoffField.ParseProto(policy.MaximumBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pubsubpb.RetryPolicy{
+		MinimumBackoff: minBackoff,
+		MaximumBackoff: maxBackoff,
+	}, nil
+}
+
+func toPubsubSubscription(id identifier.PubsubSubscriptionIdentifier, res *pubsubpb.Subscription) (pubsubsubscription.PubsubSubscription, error) {
+	var deadLetterPolicies []pubsubsubscription.DeadLetterPolicy
+	if res.GetDeadLetterPolicy() != nil {
+		matches := topicRe.FindStringSubmatch(res.GetDeadLetterPolicy().GetDeadLetterTopic())
+		if len(matches) < 3 {
+			return pubsubsubscription.PubsubSubscription{}, fmt.Errorf("invalid dead letter topic in response: %q", res.GetDeadLetterPolicy().GetDeadLetterTopic())
+		}
+
+		deadLetterPolicies = append(deadLetterPolicies, pubsubsubscription.DeadLetterPolicy{
+			DeadLetterTopic: identifier.PubsubTopicIdentifier{
+				Project: matches[1],
+				Name:    matches[2],
+			},
+			MaxDeliveryAttempts: maxDeliveryAttemptsField.Format(res.GetDeadLetterPolicy().GetMaxDeliveryAttempts()),
+		})
+	}
+
+	var retryPolicy pubsubsubscription.RetryPolicy
+	if res.GetRetryPolicy() != nil {
+		retryPolicy = pubsubsubscription.RetryPolicy{
+			MinimumBackoff: minimumBackoffField.Format(res.GetRetryPolicy().GetMinimumBackoff().AsDuration()),
+			MaximumBackoff: maximumBackoffField.Format(res.GetRetryPolicy().GetMaximumBackoff().AsDuration()),
+		}
+	}
+
+	return pubsubsubscription.PubsubSubscription{
+		Identifier: id,
+		Config: pubsubsubscription.Config{
+			Labels:                    res.GetLabels(),
+			AckDeadlineSeconds:        ackDeadlineField.Format(res.GetAckDeadlineSeconds()),
+			PushEndpoint:              res.GetPushConfig().GetPushEndpoint(),
+			DeadLetterPolicy:          deadLetterPolicies,
+			RetryPolicy:               retryPolicy,
+			Filter:                    res.GetFilter(),
+			EnableExactlyOnceDelivery: res.GetEnableExactlyOnceDelivery(),
+		},
+		Attrs: pubsubsubscription.Attrs{},
+	}, nil
+}
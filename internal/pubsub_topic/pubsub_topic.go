@@ -0,0 +1,175 @@
+package pubsub_topic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	pubsubtopic "github.com/alchematik/athanor-provider-gcp/gen/provider/pubsub_topic"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+
+	pubsub "cloud.google.com/go/pubsub/apiv1"
+	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var retentionField = fieldcodec.Duration{Name: "message_retention_duration"}
+
+func NewHandler(ctx context.Context) (*pubsubtopic.PubsubTopicHandler, error) {
+	gcp, err := pubsub.NewPublisherClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &pubsubtopic.PubsubTopicHandler{
+		PubsubTopicGetter:  c,
+		PubsubTopicCreator: c,
+		PubsubTopicUpdator: c,
+		PubsubTopicDeleter: c,
+		CloseFunc:          gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetTopic(context.Context, *pubsubpb.GetTopicRequest, ...gax.CallOption) (*pubsubpb.Topic, error)
+	CreateTopic(context.Context, *pubsubpb.Topic, ...gax.CallOption) (*pubsubpb.Topic, error)
+	UpdateTopic(context.Context, *pubsubpb.UpdateTopicRequest, ...gax.CallOption) (*pubsubpb.Topic, error)
+	DeleteTopic(context.Context, *pubsubpb.DeleteTopicRequest, ...gax.CallOption) error
+}
+
+func (c *client) GetPubsubTopic(ctx context.Context, id identifier.PubsubTopicIdentifier) (pubsubtopic.PubsubTopic, error) {
+	res, err := c.GCP.GetTopic(ctx, &pubsubpb.GetTopicRequest{
+		Topic: fmt.Sprintf("projects/%s/topics/%s", id.Project, id.Name),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return pubsubtopic.PubsubTopic{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return pubsubtopic.PubsubTopic{}, err
+	}
+
+	return toPubsubTopic(id, res), nil
+}
+
+func (c *client) CreatePubsubTopic(ctx context.Context, id identifier.PubsubTopicIdentifier, config pubsubtopic.Config) (pubsubtopic.PubsubTopic, error) {
+	retention, err := retentionField.ParseProto(config.MessageRetentionDuration)
+	if err != nil {
+		return pubsubtopic.PubsubTopic{}, err
+	}
+
+	schemaSettings, err := toSchemaSettingsPB(config.SchemaSettings)
+	if err != nil {
+		return pubsubtopic.PubsubTopic{}, err
+	}
+
+	res, err := c.GCP.CreateTopic(ctx, &pubsubpb.Topic{
+		Name:                     fmt.Sprintf("projects/%s/topics/%s", id.Project, id.Name),
+		Labels:                   config.Labels,
+		MessageRetentionDuration: retention,
+		KmsKeyName:               config.KmsKeyName,
+		SchemaSettings:           schemaSettings,
+	})
+	if err != nil {
+		return pubsubtopic.PubsubTopic{}, err
+	}
+
+	return toPubsubTopic(id, res), nil
+}
+
+func (c *client) UpdatePubsubTopic(ctx context.Context, id identifier.PubsubTopicIdentifier, config pubsubtopic.Config, mask []value.UpdateMaskField) (pubsubtopic.PubsubTopic, error) {
+	updateMask := &fieldmaskpb.FieldMask{}
+	topic := &pubsubpb.Topic{
+		Name: fmt.Sprintf("projects/%s/topics/%s", id.Project, id.Name),
+	}
+
+	for _, m := range mask {
+		switch m.Name {
+		case "labels":
+			topic.Labels = config.Labels
+			updateMask.Paths = append(updateMask.Paths, "labels")
+		case "message_retention_duration":
+			retention, err := retentionField.ParseProto(config.MessageRetentionDuration)
+			if err != nil {
+				return pubsubtopic.PubsubTopic{}, err
+			}
+			topic.MessageRetentionDuration = retention
+			updateMask.Paths = append(updateMask.Paths, "message_retention_duration")
+		case "kms_key_name":
+			topic.KmsKeyName = config.KmsKeyName
+			updateMask.Paths = append(updateMask.Paths, "kms_key_name")
+		case "schema_settings":
+			schemaSettings, err := toSchemaSettingsPB(config.SchemaSettings)
+			if err != nil {
+				return pubsubtopic.PubsubTopic{}, err
+			}
+			topic.SchemaSettings = schemaSettings
+			updateMask.Paths = append(updateMask.Paths, "schema_settings")
+		}
+	}
+
+	res, err := c.GCP.UpdateTopic(ctx, &pubsubpb.UpdateTopicRequest{
+		Topic:      topic,
+		UpdateMask: updateMask,
+	})
+	if err != nil {
+		return pubsubtopic.PubsubTopic{}, err
+	}
+
+	return toPubsubTopic(id, res), nil
+}
+
+func (c *client) DeletePubsubTopic(ctx context.Context, id identifier.PubsubTopicIdentifier) error {
+	return c.GCP.DeleteTopic(ctx, &pubsubpb.DeleteTopicRequest{
+		Topic: fmt.Sprintf("projects/%s/topics/%s", id.Project, id.Name),
+	})
+}
+
+func toSchemaSettingsPB(settings pubsubtopic.SchemaSettings) (*pubsubpb.SchemaSettings, error) {
+	if settings.Schema == "" {
+		return nil, nil
+	}
+
+	encoding, ok := pubsubpb.Encoding_value[settings.Encoding]
+	if !ok {
+		return nil, fmt.Errorf("invalid schema encoding: %s", settings.Encoding)
+	}
+
+	return &pubsubpb.SchemaSettings{
+		Schema:   settings.Schema,
+		Encoding: pubsubpb.Encoding(encoding),
+	}, nil
+}
+
+func toPubsubTopic(id identifier.PubsubTopicIdentifier, res *pubsubpb.Topic) pubsubtopic.PubsubTopic {
+	var schemaSettings pubsubtopic.SchemaSettings
+	if res.GetSchemaSettings() != nil {
+		schemaSettings = pubsubtopic.SchemaSettings{
+			Schema:   res.GetSchemaSettings().GetSchema(),
+			Encoding: res.GetSchemaSettings().GetEncoding().String(),
+		}
+	}
+
+	return pubsubtopic.PubsubTopic{
+		Identifier: id,
+		Config: pubsubtopic.Config{
+			Labels:                   res.GetLabels(),
+			MessageRetentionDuration: retentionField.Format(res.GetMessageRetentionDuration().AsDuration()),
+			KmsKeyName:               res.GetKmsKeyName(),
+			SchemaSettings:           schemaSettings,
+		},
+		Attrs: pubsubtopic.Attrs{},
+	}
+}
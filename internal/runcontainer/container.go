@@ -0,0 +1,71 @@
+// Package runcontainer holds the container conversions shared by the cloud_run_service and cloud_run_job handlers.
+package runcontainer
+
+import (
+	"sort"
+
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+
+	"cloud.google.com/go/run/apiv2/runpb"
+)
+
+var (
+	cpu    = fieldcodec.String{Name: "container.cpu", Default: "1000m"}
+	memory = fieldcodec.String{Name: "container.memory", Default: "512Mi"}
+)
+
+// ToEnvPB returns env vars sorted by name, so that the request doesn't depend on map order.
+func ToEnvPB(env map[string]string) []*runpb.EnvVar {
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	vars := make([]*runpb.EnvVar, len(names))
+	for i, name := range names {
+		vars[i] = &runpb.EnvVar{
+			Name: name,
+			Values: &runpb.EnvVar_Value{
+				Value: env[name],
+			},
+		}
+	}
+
+	return vars
+}
+
+// ToEnv is the inverse of ToEnvPB.
+func ToEnv(vars []*runpb.EnvVar) map[string]string {
+	env := map[string]string{}
+	for _, v := range vars {
+		env[v.GetName()] = v.GetValue()
+	}
+
+	return env
+}
+
+// ToResourcesPB returns the container's resource limits.
+func ToResourcesPB(cpuLimit, memoryLimit string) (*runpb.ResourceRequirements, error) {
+	c, err := cpu.Parse(cpuLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := memory.Parse(memoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runpb.ResourceRequirements{
+		Limits: map[string]string{
+			"cpu":    c,
+			"memory": m,
+		},
+	}, nil
+}
+
+// ToResources is the inverse of ToResourcesPB.
+func ToResources(resources *runpb.ResourceRequirements) (cpuLimit, memoryLimit string) {
+	return cpu.Format(resources.GetLimits()["cpu"]), memory.Format(resources.GetLimits()["memory"])
+}
@@ -0,0 +1,294 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/secret"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+	"github.com/alchematik/athanor-provider-gcp/internal/secretannotation"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	topicRe = regexp.MustCompile(`projects\/(.+)\/topics\/(.+)`)
+)
+
+var (
+	rotationPeriodField = fieldcodec.Duration{Name: "rotation_period"}
+	ttlField            = fieldcodec.Duration{Name: "ttl"}
+)
+
+func NewHandler(ctx context.Context) (*secret.SecretHandler, error) {
+	gcp, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &secret.SecretHandler{
+		SecretGetter:  c,
+		SecretCreator: c,
+		SecretUpdator: c,
+		SecretDeleter: c,
+		CloseFunc:     gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetSecret(context.Context, *secretmanagerpb.GetSecretRequest, ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	CreateSecret(context.Context, *secretmanagerpb.CreateSecretRequest, ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	UpdateSecret(context.Context, *secretmanagerpb.UpdateSecretRequest, ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	DeleteSecret(context.Context, *secretmanagerpb.DeleteSecretRequest, ...gax.CallOption) error
+}
+
+func (c *client) GetSecret(ctx context.Context, id identifier.SecretIdentifier) (secret.Secret, error) {
+	res, err := c.GCP.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", id.Project, id.Name),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return secret.Secret{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return secret.Secret{}, err
+	}
+
+	return toSecret(id, res)
+}
+
+func (c *client) CreateSecret(ctx context.Context, id identifier.SecretIdentifier, config secret.Config) (secret.Secret, error) {
+	topics, err := toTopicsPB(config.Topics)
+	if err != nil {
+		return secret.Secret{}, err
+	}
+
+	rotation, err := toRotationPB(config.RotationPeriod)
+	if err != nil {
+		return secret.Secret{}, err
+	}
+
+	annotations, err := secretannotation.Merge(config.Annotations, nil)
+	if err != nil {
+		return secret.Secret{}, err
+	}
+
+	s := &secretmanagerpb.Secret{
+		Labels:      config.Labels,
+		Annotations: annotations,
+		Replication: toReplicationPB(config.ReplicationLocations),
+		Topics:      topics,
+		Rotation:    rotation,
+	}
+
+	ttl, err := ttlField.ParseProto(config.Ttl)
+	if err != nil {
+		return secret.Secret{}, err
+	}
+	if ttl != nil {
+		s.Expiration = &secretmanagerpb.Secret_Ttl{
+			Ttl: ttl,
+		}
+	}
+
+	res, err := c.GCP.CreateSecret(ctx, &secretmanagerpb.CreateSecretRequest{
+		Parent:   fmt.Sprintf("projects/%s", id.Project),
+		SecretId: id.Name,
+		Secret:   s,
+	})
+	if err != nil {
+		return secret.Secret{}, err
+	}
+
+	return toSecret(id, res)
+}
+
+func (c *client) UpdateSecret(ctx context.Context, id identifier.SecretIdentifier, config secret.Config, mask []value.UpdateMaskField) (secret.Secret, error) {
+	updateMask := &fieldmaskpb.FieldMask{}
+	s := &secretmanagerpb.Secret{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", id.Project, id.Name),
+	}
+
+	for _, m := range mask {
+		switch m.Name {
+		case "labels":
+			s.Labels = config.Labels
+			updateMask.Paths = append(updateMask.Paths, "labels")
+		case "annotations":
+			// The annotations tracking secret versions are kept. The etag makes sure none were added in the meantime.
+			existing, err := c.GCP.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+				Name: s.GetName(),
+			})
+			if err != nil {
+				return secret.Secret{}, err
+			}
+
+			_, versions := secretannotation.Split(existing.GetAnnotations())
+			annotations, err := secretannotation.Merge(config.Annotations, versions)
+			if err != nil {
+				return secret.Secret{}, err
+			}
+			s.Annotations = annotations
+			s.Etag = existing.GetEtag()
+			updateMask.Paths = append(updateMask.Paths, "annotations")
+		case "topics":
+			topics, err := toTopicsPB(config.Topics)
+			if err != nil {
+				return secret.Secret{}, err
+			}
+			s.Topics = topics
+			updateMask.Paths = append(updateMask.Paths, "topics")
+		case "rotation_period":
+			rotation, err := toRotationPB(config.RotationPeriod)
+			if err != nil {
+				return secret.Secret{}, err
+			}
+			s.Rotation = rotation
+			updateMask.Paths = append(updateMask.Paths, "rotation")
+		}
+	}
+
+	res, err := c.GCP.UpdateSecret(ctx, &secretmanagerpb.UpdateSecretRequest{
+		Secret:     s,
+		UpdateMask: updateMask,
+	})
+	if err != nil {
+		return secret.Secret{}, err
+	}
+
+	return toSecret(id, res)
+}
+
+func (c *client) DeleteSecret(ctx context.Context, id identifier.SecretIdentifier) error {
+	return c.GCP.DeleteSecret(ctx, &secretmanagerpb.DeleteSecretRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", id.Project, id.Name),
+	})
+}
+
+func toReplicationPB(locations []string) *secretmanagerpb.Replication {
+	if len(locations) == 0 {
+		return &secretmanagerpb.Replication{
+			Replication: &secretmanagerpb.Replication_Automatic_{
+				Automatic: &secretmanagerpb.Replication_Automatic{},
+			},
+		}
+	}
+
+	replicas := make([]*secretmanagerpb.Replication_UserManaged_Replica, len(locations))
+	for i, location := range locations {
+		replicas[i] = &secretmanagerpb.Replication_UserManaged_Replica{
+			Location: location,
+		}
+	}
+
+	return &secretmanagerpb.Replication{
+		Replication: &secretmanagerpb.Replication_UserManaged_{
+			UserManaged: &secretmanagerpb.Replication_UserManaged{
+				Replicas: replicas,
+			},
+		},
+	}
+}
+
+func toTopicsPB(topics []value.ResourceIdentifier) ([]*secretmanagerpb.Topic, error) {
+	out := make([]*secretmanagerpb.Topic, len(topics))
+	for i, t := range topics {
+		topicID, ok := t.(identifier.PubsubTopicIdentifier)
+		if !ok {
+			return nil, fmt.Errorf("field topics must be a list of pubsub_topic identifiers")
+		}
+
+		out[i] = &secretmanagerpb.Topic{
+			Name: fmt.Sprintf("projects/%s/topics/%s", topicID.Project, topicID.Name),
+		}
+	}
+
+	return out, nil
+}
+
+// toRotationPB schedules the first rotation one period from now. GCP advances the next rotation time
+// itself after that, so it's only exposed as an attr.
+func toRotationPB(period string) (*secretmanagerpb.Rotation, error) {
+	d, err := rotationPeriodField.Parse(period)
+	if err != nil || d == 0 {
+		return nil, err
+	}
+
+	return &secretmanagerpb.Rotation{
+		NextRotationTime: timestamppb.New(time.Now().Add(d)),
+		RotationPeriod:   durationpb.New(d),
+	}, nil
+}
+
+func toSecret(id identifier.SecretIdentifier, res *secretmanagerpb.Secret) (secret.Secret, error) {
+	var locations []string
+	for _, replica := range res.GetReplication().GetUserManaged().GetReplicas() {
+		locations = append(locations, replica.GetLocation())
+	}
+
+	topics := make([]value.ResourceIdentifier, len(res.GetTopics()))
+	for i, t := range res.GetTopics() {
+		matches := topicRe.FindStringSubmatch(t.GetName())
+		if len(matches) < 3 {
+			return secret.Secret{}, fmt.Errorf("invalid topic in response: %q", t.GetName())
+		}
+
+		topics[i] = identifier.PubsubTopicIdentifier{
+			Project: matches[1],
+			Name:    matches[2],
+		}
+	}
+
+	var rotationPeriod, nextRotationTime string
+	if res.GetRotation() != nil {
+		rotationPeriod = rotationPeriodField.Format(res.GetRotation().GetRotationPeriod().AsDuration())
+		nextRotationTime = res.GetRotation().GetNextRotationTime().String()
+	}
+
+	// The TTL is input only, so it's derived from the expiration. ttl is immutable, so the
+	// expiration is always relative to the creation time.
+	var ttl, expireTime string
+	if res.GetExpireTime() != nil {
+		ttl = ttlField.Format(res.GetExpireTime().AsTime().Sub(res.GetCreateTime().AsTime()))
+		expireTime = res.GetExpireTime().String()
+	}
+
+	annotations, _ := secretannotation.Split(res.GetAnnotations())
+
+	return secret.Secret{
+		Identifier: id,
+		Config: secret.Config{
+			Labels:               res.GetLabels(),
+			Annotations:          annotations,
+			ReplicationLocations: locations,
+			Topics:               topics,
+			RotationPeriod:       rotationPeriod,
+			Ttl:                  ttl,
+		},
+		Attrs: secret.Attrs{
+			Create:           res.GetCreateTime().String(),
+			ExpireTime:       expireTime,
+			NextRotationTime: nextRotationTime,
+			Etag:             res.GetEtag(),
+		},
+	}, nil
+}
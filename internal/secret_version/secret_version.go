@@ -0,0 +1,348 @@
+package secret_version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"strings"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	secretversion "github.com/alchematik/athanor-provider-gcp/gen/provider/secret_version"
+	"github.com/alchematik/athanor-provider-gcp/internal/secretannotation"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func NewHandler(ctx context.Context) (*secretversion.SecretVersionHandler, error) {
+	gcp, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: gcp,
+	}
+	return &secretversion.SecretVersionHandler{
+		SecretVersionGetter:  c,
+		SecretVersionCreator: c,
+		SecretVersionUpdator: c,
+		SecretVersionDeleter: c,
+		CloseFunc:            gcp.Close,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	GetSecret(context.Context, *secretmanagerpb.GetSecretRequest, ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	UpdateSecret(context.Context, *secretmanagerpb.UpdateSecretRequest, ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	GetSecretVersion(context.Context, *secretmanagerpb.GetSecretVersionRequest, ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+	AccessSecretVersion(context.Context, *secretmanagerpb.AccessSecretVersionRequest, ...gax.CallOption) (*secretmanagerpb.AccessSecretVersionResponse, error)
+	AddSecretVersion(context.Context, *secretmanagerpb.AddSecretVersionRequest, ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+	EnableSecretVersion(context.Context, *secretmanagerpb.EnableSecretVersionRequest, ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+	DisableSecretVersion(context.Context, *secretmanagerpb.DisableSecretVersionRequest, ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+	DestroySecretVersion(context.Context, *secretmanagerpb.DestroySecretVersionRequest, ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+}
+
+func (c *client) GetSecretVersion(ctx context.Context, id identifier.SecretVersionIdentifier) (secretversion.SecretVersion, error) {
+	s, tracked, err := c.getTracked(ctx, id)
+	if err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	res, err := c.getVersion(ctx, s, tracked)
+	if err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	// A disabled or destroyed version is what's left behind after a delete.
+	if res.GetState() != secretmanagerpb.SecretVersion_ENABLED {
+		return secretversion.SecretVersion{}, sdkerrors.NewErrorNotFound()
+	}
+
+	access, err := c.GCP.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
+		Name: res.GetName(),
+	})
+	if err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	// Only the checksum of the payload is returned so that secret contents never show up in diffs.
+	checksum := crc32.Checksum(access.GetPayload().GetData(), crc32.MakeTable(crc32.Castagnoli))
+
+	return toSecretVersion(id, res, checksum, tracked.DestroyOnDelete), nil
+}
+
+func (c *client) CreateSecretVersion(ctx context.Context, id identifier.SecretVersionIdentifier, config secretversion.Config) (secretversion.SecretVersion, error) {
+	s, tracked, err := c.getTracked(ctx, id)
+	if err != nil && !errors.As(err, new(sdkerrors.ErrorNotFound)) {
+		return secretversion.SecretVersion{}, err
+	}
+
+	data, err := os.ReadFile(config.Contents.Path)
+	if err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	checksum := crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))
+
+	// A version disabled by a previous delete is enabled again, as long as it has the same contents.
+	if s != nil && tracked.Checksum == fmt.Sprintf("%d", checksum) {
+		res, err := c.getVersion(ctx, s, tracked)
+		if err != nil && !errors.As(err, new(sdkerrors.ErrorNotFound)) {
+			return secretversion.SecretVersion{}, err
+		}
+
+		if res.GetState() == secretmanagerpb.SecretVersion_DISABLED {
+			res, err = c.GCP.EnableSecretVersion(ctx, &secretmanagerpb.EnableSecretVersionRequest{
+				Name: res.GetName(),
+			})
+			if err != nil {
+				return secretversion.SecretVersion{}, err
+			}
+
+			if tracked.DestroyOnDelete != config.DestroyOnDelete {
+				tracked.DestroyOnDelete = config.DestroyOnDelete
+				if err := c.track(ctx, s, id.Name, tracked); err != nil {
+					return secretversion.SecretVersion{}, err
+				}
+			}
+
+			return toSecretVersion(id, res, checksum, config.DestroyOnDelete), nil
+		}
+	}
+
+	return c.addVersion(ctx, id, config, data)
+}
+
+// UpdateSecretVersion adds a new version when the contents change, since versions are immutable, and retires the
+// previous one the same way a delete would.
+func (c *client) UpdateSecretVersion(ctx context.Context, id identifier.SecretVersionIdentifier, config secretversion.Config, mask []value.UpdateMaskField) (secretversion.SecretVersion, error) {
+	s, tracked, err := c.getTracked(ctx, id)
+	if err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	for _, m := range mask {
+		if m.Name != "contents" {
+			continue
+		}
+
+		data, err := os.ReadFile(config.Contents.Path)
+		if err != nil {
+			return secretversion.SecretVersion{}, err
+		}
+
+		res, err := c.addVersion(ctx, id, config, data)
+		if err != nil {
+			return secretversion.SecretVersion{}, err
+		}
+
+		if err := c.retire(ctx, versionName(s.GetName(), tracked.Version), config.DestroyOnDelete); err != nil {
+			return secretversion.SecretVersion{}, err
+		}
+
+		return res, nil
+	}
+
+	// Only destroy_on_delete changed.
+	tracked.DestroyOnDelete = config.DestroyOnDelete
+	if err := c.track(ctx, s, id.Name, tracked); err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	return c.GetSecretVersion(ctx, id)
+}
+
+func (c *client) DeleteSecretVersion(ctx context.Context, id identifier.SecretVersionIdentifier) error {
+	s, tracked, err := c.getTracked(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := c.retire(ctx, versionName(s.GetName(), tracked.Version), tracked.DestroyOnDelete); err != nil {
+		return err
+	}
+
+	// A disabled version stays tracked, so that it's enabled again if the secret_version is recreated.
+	if !tracked.DestroyOnDelete {
+		return nil
+	}
+
+	annotations, err := secretannotation.Remove(s.GetAnnotations(), id.Name)
+	if err != nil {
+		return err
+	}
+
+	return c.updateAnnotations(ctx, s, annotations)
+}
+
+// getTracked returns the secret along with the version tracked under the name of the secret_version.
+func (c *client) getTracked(ctx context.Context, id identifier.SecretVersionIdentifier) (*secretmanagerpb.Secret, secretannotation.Version, error) {
+	secretID, ok := id.Secret.(identifier.SecretIdentifier)
+	if !ok {
+		return nil, secretannotation.Version{}, fmt.Errorf("field secret must be a secret identifier")
+	}
+
+	s, err := c.GCP.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", secretID.Project, secretID.Name),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, secretannotation.Version{}, sdkerrors.NewErrorNotFound()
+		}
+
+		return nil, secretannotation.Version{}, err
+	}
+
+	tracked, ok, err := secretannotation.Get(s.GetAnnotations(), id.Name)
+	if err != nil {
+		return nil, secretannotation.Version{}, err
+	}
+	if !ok {
+		return s, secretannotation.Version{}, sdkerrors.NewErrorNotFound()
+	}
+
+	return s, tracked, nil
+}
+
+func (c *client) getVersion(ctx context.Context, s *secretmanagerpb.Secret, tracked secretannotation.Version) (*secretmanagerpb.SecretVersion, error) {
+	res, err := c.GCP.GetSecretVersion(ctx, &secretmanagerpb.GetSecretVersionRequest{
+		Name: versionName(s.GetName(), tracked.Version),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, sdkerrors.NewErrorNotFound()
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// addVersion adds a version with the contents and tracks it under the name of the secret_version.
+func (c *client) addVersion(ctx context.Context, id identifier.SecretVersionIdentifier, config secretversion.Config, data []byte) (secretversion.SecretVersion, error) {
+	secretID, ok := id.Secret.(identifier.SecretIdentifier)
+	if !ok {
+		return secretversion.SecretVersion{}, fmt.Errorf("field secret must be a secret identifier")
+	}
+
+	// Checked before adding the version, so that an invalid name doesn't leave an untracked version behind.
+	if _, err := secretannotation.Key(id.Name); err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	checksum := crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))
+	dataCRC32C := int64(checksum)
+
+	res, err := c.GCP.AddSecretVersion(ctx, &secretmanagerpb.AddSecretVersionRequest{
+		Parent: fmt.Sprintf("projects/%s/secrets/%s", secretID.Project, secretID.Name),
+		Payload: &secretmanagerpb.SecretPayload{
+			Data:       data,
+			DataCrc32C: &dataCRC32C,
+		},
+	})
+	if err != nil {
+		return secretversion.SecretVersion{}, err
+	}
+
+	// The secret is read after adding the version, so that its etag is current.
+	s, err := c.GCP.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", secretID.Project, secretID.Name),
+	})
+	if err == nil {
+		err = c.track(ctx, s, id.Name, secretannotation.Version{
+			Version:         versionNumber(res.GetName()),
+			Checksum:        fmt.Sprintf("%d", checksum),
+			DestroyOnDelete: config.DestroyOnDelete,
+		})
+	}
+	if err != nil {
+		// An untracked version would never be found again, so it's destroyed rather than left behind.
+		_, destroyErr := c.GCP.DestroySecretVersion(ctx, &secretmanagerpb.DestroySecretVersionRequest{
+			Name: res.GetName(),
+		})
+		return secretversion.SecretVersion{}, errors.Join(err, destroyErr)
+	}
+
+	return toSecretVersion(id, res, checksum, config.DestroyOnDelete), nil
+}
+
+// retire destroys or disables a version.
+func (c *client) retire(ctx context.Context, name string, destroy bool) error {
+	if destroy {
+		_, err := c.GCP.DestroySecretVersion(ctx, &secretmanagerpb.DestroySecretVersionRequest{
+			Name: name,
+		})
+		return err
+	}
+
+	_, err := c.GCP.DisableSecretVersion(ctx, &secretmanagerpb.DisableSecretVersionRequest{
+		Name: name,
+	})
+	return err
+}
+
+func (c *client) track(ctx context.Context, s *secretmanagerpb.Secret, name string, tracked secretannotation.Version) error {
+	annotations, err := secretannotation.Set(s.GetAnnotations(), name, tracked)
+	if err != nil {
+		return err
+	}
+
+	return c.updateAnnotations(ctx, s, annotations)
+}
+
+// updateAnnotations uses the etag of the secret, so that concurrent changes to its annotations aren't lost.
+func (c *client) updateAnnotations(ctx context.Context, s *secretmanagerpb.Secret, annotations map[string]string) error {
+	_, err := c.GCP.UpdateSecret(ctx, &secretmanagerpb.UpdateSecretRequest{
+		Secret: &secretmanagerpb.Secret{
+			Name:        s.GetName(),
+			Annotations: annotations,
+			Etag:        s.GetEtag(),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"annotations"},
+		},
+	})
+	return err
+}
+
+func versionName(secretName, version string) string {
+	return fmt.Sprintf("%s/versions/%s", secretName, version)
+}
+
+// versionNumber returns the number of a version name, which has the form of
+// projects/<project>/secrets/<secret>/versions/<version>.
+func versionNumber(name string) string {
+	parts := strings.Split(name, "/")
+	return parts[len(parts)-1]
+}
+
+func toSecretVersion(id identifier.SecretVersionIdentifier, res *secretmanagerpb.SecretVersion, checksum uint32, destroyOnDelete bool) secretversion.SecretVersion {
+	return secretversion.SecretVersion{
+		Identifier: id,
+		Config: secretversion.Config{
+			Contents: value.File{
+				Checksum: fmt.Sprintf("%d", checksum),
+			},
+			DestroyOnDelete: destroyOnDelete,
+		},
+		Attrs: secretversion.Attrs{
+			Version: versionNumber(res.GetName()),
+			State:   res.GetState().String(),
+			Create:  res.GetCreateTime().String(),
+		},
+	}
+}
@@ -0,0 +1,133 @@
+// Package secretannotation tracks secret_version resources in annotations on their secret.
+//
+// GCP numbers versions itself and doesn't store any metadata on them, while the getter and deleter of a
+// secret_version only receive its identifier. Each version's number and settings are therefore kept in an
+// annotation on the secret, keyed by the name in the identifier. The secret handler leaves these
+// annotations alone.
+package secretannotation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const prefix = "athanor-version-"
+
+// Annotation keys are at most 63 characters and must start and end with an alphanumeric character.
+var nameRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]{0,45}[a-zA-Z0-9])?$`)
+
+// Version is the annotation value of a secret_version.
+type Version struct {
+	Version string `json:"version"`
+	// Checksum is the CRC32C of the contents, which are unreadable once the version is disabled.
+	Checksum        string `json:"checksum"`
+	DestroyOnDelete bool   `json:"destroy_on_delete,omitempty"`
+}
+
+// Key returns the annotation key of the secret_version with the given name.
+func Key(name string) (string, error) {
+	if !nameRe.MatchString(name) {
+		return "", fmt.Errorf("invalid value for name: %q", name)
+	}
+
+	return prefix + name, nil
+}
+
+// Get returns the version stored under the given name, if there is one.
+func Get(annotations map[string]string, name string) (Version, bool, error) {
+	key, err := Key(name)
+	if err != nil {
+		return Version{}, false, err
+	}
+
+	val, ok := annotations[key]
+	if !ok {
+		return Version{}, false, nil
+	}
+
+	var v Version
+	if err := json.Unmarshal([]byte(val), &v); err != nil {
+		return Version{}, false, fmt.Errorf("invalid annotation %s: %v", key, err)
+	}
+
+	return v, true, nil
+}
+
+// Set returns a copy of annotations with the version stored under the given name.
+func Set(annotations map[string]string, name string, v Version) (map[string]string, error) {
+	key, err := Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]string{key: string(val)}
+	for k, v := range annotations {
+		if k != key {
+			out[k] = v
+		}
+	}
+
+	return out, nil
+}
+
+// Remove returns a copy of annotations without the version stored under the given name.
+func Remove(annotations map[string]string, name string) (map[string]string, error) {
+	key, err := Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]string{}
+	for k, v := range annotations {
+		if k != key {
+			out[k] = v
+		}
+	}
+
+	return out, nil
+}
+
+// Split separates the annotations set through the secret's config from the ones tracking versions.
+func Split(annotations map[string]string) (config map[string]string, versions map[string]string) {
+	for k, v := range annotations {
+		if strings.HasPrefix(k, prefix) {
+			if versions == nil {
+				versions = map[string]string{}
+			}
+			versions[k] = v
+			continue
+		}
+
+		if config == nil {
+			config = map[string]string{}
+		}
+		config[k] = v
+	}
+
+	return config, versions
+}
+
+// Merge returns the config annotations of a secret together with the annotations tracking its versions.
+// Config annotations can't use the reserved prefix.
+func Merge(config map[string]string, versions map[string]string) (map[string]string, error) {
+	out := map[string]string{}
+	for k, v := range config {
+		if strings.HasPrefix(k, prefix) {
+			return nil, fmt.Errorf("annotations with the prefix %q are reserved for secret_version", prefix)
+		}
+		out[k] = v
+	}
+
+	for k, v := range versions {
+		out[k] = v
+	}
+
+	return out, nil
+}
@@ -12,8 +12,10 @@ import (
 	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
 	"github.com/alchematik/athanor-go/sdk/provider/value"
 	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func NewHandler(ctx context.Context) (*serviceaccount.ServiceAccountHandler, error) {
@@ -23,6 +25,8 @@ func NewHandler(ctx context.Context) (*serviceaccount.ServiceAccountHandler, err
 	}
 	c := &client{
 		GCP: gcp,
+		// The generated client doesn't expose enabling and disabling accounts, so the underlying stub is used for those.
+		State: adminpb.NewIAMClient(gcp.Connection()),
 	}
 	return &serviceaccount.ServiceAccountHandler{
 		ServiceAccountGetter:  c,
@@ -34,7 +38,8 @@ func NewHandler(ctx context.Context) (*serviceaccount.ServiceAccountHandler, err
 }
 
 type client struct {
-	GCP GCP
+	GCP   GCP
+	State State
 }
 
 type GCP interface {
@@ -44,9 +49,14 @@ type GCP interface {
 	DeleteServiceAccount(context.Context, *adminpb.DeleteServiceAccountRequest, ...gax.CallOption) error
 }
 
+type State interface {
+	EnableServiceAccount(context.Context, *adminpb.EnableServiceAccountRequest, ...grpc.CallOption) (*emptypb.Empty, error)
+	DisableServiceAccount(context.Context, *adminpb.DisableServiceAccountRequest, ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
 func (c *client) GetServiceAccount(ctx context.Context, id identifier.ServiceAccountIdentifier) (serviceaccount.ServiceAccount, error) {
 	req, err := c.GCP.GetServiceAccount(ctx, &adminpb.GetServiceAccountRequest{
-		Name: fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", id.Project, id.AccountId, id.Project),
+		Name: serviceAccountName(id),
 	})
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -56,17 +66,7 @@ func (c *client) GetServiceAccount(ctx context.Context, id identifier.ServiceAcc
 		return serviceaccount.ServiceAccount{}, err
 	}
 
-	return serviceaccount.ServiceAccount{
-		Identifier: id,
-		Config: serviceaccount.Config{
-			DisplayName: req.GetDisplayName(),
-			Description: req.GetDescription(),
-		},
-		Attrs: serviceaccount.Attrs{
-			UniqueId: req.GetUniqueId(),
-			Disabled: req.GetDisabled(),
-		},
-	}, nil
+	return toServiceAccount(id, req), nil
 }
 
 func (c *client) CreateServiceAccount(ctx context.Context, id identifier.ServiceAccountIdentifier, config serviceaccount.Config) (serviceaccount.ServiceAccount, error) {
@@ -82,44 +82,83 @@ func (c *client) CreateServiceAccount(ctx context.Context, id identifier.Service
 		return serviceaccount.ServiceAccount{}, err
 	}
 
-	return serviceaccount.ServiceAccount{
-		Identifier: id,
-		Config: serviceaccount.Config{
-			DisplayName: res.GetDisplayName(),
-			Description: res.GetDescription(),
-		},
-		Attrs: serviceaccount.Attrs{
-			UniqueId: res.GetUniqueId(),
-			Disabled: res.GetDisabled(),
-		},
-	}, nil
+	// Accounts are always created enabled.
+	if config.Disabled {
+		if err := c.setDisabled(ctx, id, true); err != nil {
+			return serviceaccount.ServiceAccount{}, err
+		}
+
+		res.Disabled = true
+	}
+
+	return toServiceAccount(id, res), nil
 }
 
 func (c *client) UpdateServiceAccount(ctx context.Context, id identifier.ServiceAccountIdentifier, config serviceaccount.Config, mask []value.UpdateMaskField) (serviceaccount.ServiceAccount, error) {
-	res, err := c.GCP.UpdateServiceAccount(ctx, &adminpb.ServiceAccount{
-		Name:        fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", id.Project, id.AccountId, id.Project),
-		DisplayName: config.DisplayName,
-		Description: config.Description,
+	var updateDetails, updateDisabled bool
+	for _, m := range mask {
+		switch m.Name {
+		case "display_name", "description":
+			updateDetails = true
+		case "disabled":
+			updateDisabled = true
+		}
+	}
+
+	if updateDetails {
+		if _, err := c.GCP.UpdateServiceAccount(ctx, &adminpb.ServiceAccount{
+			Name:        serviceAccountName(id),
+			DisplayName: config.DisplayName,
+			Description: config.Description,
+		}); err != nil {
+			return serviceaccount.ServiceAccount{}, err
+		}
+	}
+
+	if updateDisabled {
+		if err := c.setDisabled(ctx, id, config.Disabled); err != nil {
+			return serviceaccount.ServiceAccount{}, err
+		}
+	}
+
+	// Neither call returns the disabled state, so the account is read back.
+	return c.GetServiceAccount(ctx, id)
+}
+
+func (c *client) DeleteServiceAccount(ctx context.Context, id identifier.ServiceAccountIdentifier) error {
+	return c.GCP.DeleteServiceAccount(ctx, &adminpb.DeleteServiceAccountRequest{
+		Name: serviceAccountName(id),
 	})
-	if err != nil {
-		return serviceaccount.ServiceAccount{}, err
+}
+
+func (c *client) setDisabled(ctx context.Context, id identifier.ServiceAccountIdentifier, disabled bool) error {
+	if disabled {
+		_, err := c.State.DisableServiceAccount(ctx, &adminpb.DisableServiceAccountRequest{
+			Name: serviceAccountName(id),
+		})
+		return err
 	}
 
+	_, err := c.State.EnableServiceAccount(ctx, &adminpb.EnableServiceAccountRequest{
+		Name: serviceAccountName(id),
+	})
+	return err
+}
+
+func serviceAccountName(id identifier.ServiceAccountIdentifier) string {
+	return fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", id.Project, id.AccountId, id.Project)
+}
+
+func toServiceAccount(id identifier.ServiceAccountIdentifier, res *adminpb.ServiceAccount) serviceaccount.ServiceAccount {
 	return serviceaccount.ServiceAccount{
 		Identifier: id,
 		Config: serviceaccount.Config{
 			DisplayName: res.GetDisplayName(),
 			Description: res.GetDescription(),
+			Disabled:    res.GetDisabled(),
 		},
 		Attrs: serviceaccount.Attrs{
 			UniqueId: res.GetUniqueId(),
-			Disabled: res.GetDisabled(),
 		},
-	}, nil
-}
-
-func (c *client) DeleteServiceAccount(ctx context.Context, id identifier.ServiceAccountIdentifier) error {
-	return c.GCP.DeleteServiceAccount(ctx, &adminpb.DeleteServiceAccountRequest{
-		Name: fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", id.Project, id.AccountId, id.Project),
-	})
+	}
 }
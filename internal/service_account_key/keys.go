@@ -0,0 +1,39 @@
+package service_account_key
+
+import (
+	"context"
+
+	iam "google.golang.org/api/iam/v1"
+)
+
+// keys adapts the REST API to GCP. The gRPC API has no way to set a key's description, which is where keys are
+// tagged, so the REST API is used instead.
+type keys struct {
+	Keys *iam.ProjectsServiceAccountsKeysService
+}
+
+func (k *keys) ListKeys(ctx context.Context, serviceAccount string) ([]*iam.ServiceAccountKey, error) {
+	res, err := k.Keys.List(serviceAccount).KeyTypes("USER_MANAGED").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Keys, nil
+}
+
+func (k *keys) CreateKey(ctx context.Context, serviceAccount string, req *iam.CreateServiceAccountKeyRequest) (*iam.ServiceAccountKey, error) {
+	return k.Keys.Create(serviceAccount, req).Context(ctx).Do()
+}
+
+func (k *keys) UploadKey(ctx context.Context, serviceAccount string, req *iam.UploadServiceAccountKeyRequest) (*iam.ServiceAccountKey, error) {
+	return k.Keys.Upload(serviceAccount, req).Context(ctx).Do()
+}
+
+func (k *keys) PatchKey(ctx context.Context, name string, req *iam.PatchServiceAccountKeyRequest) (*iam.ServiceAccountKey, error) {
+	return k.Keys.Patch(name, req).Context(ctx).Do()
+}
+
+func (k *keys) DeleteKey(ctx context.Context, name string) error {
+	_, err := k.Keys.Delete(name).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,297 @@
+package service_account_key
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alchematik/athanor-provider-gcp/gen/provider/identifier"
+	serviceaccountkey "github.com/alchematik/athanor-provider-gcp/gen/provider/service_account_key"
+	"github.com/alchematik/athanor-provider-gcp/internal/fieldcodec"
+
+	sdkerrors "github.com/alchematik/athanor-go/sdk/errors"
+	"github.com/alchematik/athanor-go/sdk/provider/value"
+	"google.golang.org/api/googleapi"
+	iam "google.golang.org/api/iam/v1"
+)
+
+// GCP reports the algorithm of keys it creates, which is RSA 2048 unless set.
+var keyAlgorithmField = fieldcodec.String{Name: "key_algorithm", Default: "KEY_ALG_RSA_2048"}
+
+func NewHandler(ctx context.Context) (*serviceaccountkey.ServiceAccountKeyHandler, error) {
+	svc, err := iam.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{
+		GCP: &keys{Keys: svc.Projects.ServiceAccounts.Keys},
+	}
+	return &serviceaccountkey.ServiceAccountKeyHandler{
+		ServiceAccountKeyGetter:  c,
+		ServiceAccountKeyCreator: c,
+		ServiceAccountKeyUpdator: c,
+		ServiceAccountKeyDeleter: c,
+	}, nil
+}
+
+type client struct {
+	GCP GCP
+}
+
+type GCP interface {
+	ListKeys(context.Context, string) ([]*iam.ServiceAccountKey, error)
+	CreateKey(context.Context, string, *iam.CreateServiceAccountKeyRequest) (*iam.ServiceAccountKey, error)
+	UploadKey(context.Context, string, *iam.UploadServiceAccountKeyRequest) (*iam.ServiceAccountKey, error)
+	PatchKey(context.Context, string, *iam.PatchServiceAccountKeyRequest) (*iam.ServiceAccountKey, error)
+	DeleteKey(context.Context, string) error
+}
+
+// tag is stored as the description of the key, since GCP doesn't store any other metadata for keys. It's how
+// keys are found again, given that the deleter and getter only receive the identifier.
+type tag struct {
+	Name    string            `json:"athanor_key"`
+	Keepers map[string]string `json:"keepers,omitempty"`
+	// PublicKeyFile is the checksum of the uploaded certificate.
+	PublicKeyFile string `json:"public_key_file,omitempty"`
+}
+
+func (c *client) GetServiceAccountKey(ctx context.Context, id identifier.ServiceAccountKeyIdentifier) (serviceaccountkey.ServiceAccountKey, error) {
+	keys, err := c.findKeys(ctx, id)
+	if err != nil {
+		return serviceaccountkey.ServiceAccountKey{}, err
+	}
+
+	if len(keys) == 0 {
+		return serviceaccountkey.ServiceAccountKey{}, sdkerrors.NewErrorNotFound()
+	}
+
+	// If a rotation was interrupted there can be more than one key, in which case the newest one counts.
+	newest := keys[0]
+	for _, k := range keys[1:] {
+		if validAfter(k).After(validAfter(newest)) {
+			newest = k
+		}
+	}
+
+	// The private key is only available when the key is created.
+	return toServiceAccountKey(id, newest, "")
+}
+
+func (c *client) CreateServiceAccountKey(ctx context.Context, id identifier.ServiceAccountKeyIdentifier, config serviceaccountkey.Config) (serviceaccountkey.ServiceAccountKey, error) {
+	return c.createKey(ctx, id, config)
+}
+
+// UpdateServiceAccountKey rotates the key. Keys can't be changed, so any change to the config, including to
+// keepers, creates a new key and deletes the old one.
+func (c *client) UpdateServiceAccountKey(ctx context.Context, id identifier.ServiceAccountKeyIdentifier, config serviceaccountkey.Config, mask []value.UpdateMaskField) (serviceaccountkey.ServiceAccountKey, error) {
+	old, err := c.findKeys(ctx, id)
+	if err != nil {
+		return serviceaccountkey.ServiceAccountKey{}, err
+	}
+
+	key, err := c.createKey(ctx, id, config)
+	if err != nil {
+		return serviceaccountkey.ServiceAccountKey{}, err
+	}
+
+	if err := c.deleteKeys(ctx, old); err != nil {
+		return serviceaccountkey.ServiceAccountKey{}, err
+	}
+
+	return key, nil
+}
+
+func (c *client) DeleteServiceAccountKey(ctx context.Context, id identifier.ServiceAccountKeyIdentifier) error {
+	keys, err := c.findKeys(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.deleteKeys(ctx, keys)
+}
+
+// createKey has GCP create the key, unless public_key_file is set, in which case the certificate is uploaded. Only
+// keys created by GCP come with a private key.
+func (c *client) createKey(ctx context.Context, id identifier.ServiceAccountKeyIdentifier, config serviceaccountkey.Config) (serviceaccountkey.ServiceAccountKey, error) {
+	serviceAccountID, ok := id.ServiceAccount.(identifier.ServiceAccountIdentifier)
+	if !ok {
+		return serviceaccountkey.ServiceAccountKey{}, fmt.Errorf("field service_account must be a service_account identifier")
+	}
+
+	t := tag{
+		Name:    id.Name,
+		Keepers: config.Keepers,
+	}
+
+	var res *iam.ServiceAccountKey
+	var credentials string
+	if config.PublicKeyFile.Path != "" {
+		if config.KeyAlgorithm != "" {
+			return serviceaccountkey.ServiceAccountKey{}, fmt.Errorf("key_algorithm can't be set along with public_key_file, it's taken from the certificate")
+		}
+
+		data, err := os.ReadFile(config.PublicKeyFile.Path)
+		if err != nil {
+			return serviceaccountkey.ServiceAccountKey{}, err
+		}
+
+		res, err = c.GCP.UploadKey(ctx, serviceAccountName(serviceAccountID), &iam.UploadServiceAccountKeyRequest{
+			PublicKeyData: base64.StdEncoding.EncodeToString(data),
+		})
+		if err != nil {
+			return serviceaccountkey.ServiceAccountKey{}, err
+		}
+
+		t.PublicKeyFile = fmt.Sprintf("%d", crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)))
+	} else {
+		algorithm, err := keyAlgorithmField.Parse(config.KeyAlgorithm)
+		if err != nil {
+			return serviceaccountkey.ServiceAccountKey{}, err
+		}
+		if algorithm != "KEY_ALG_RSA_1024" && algorithm != "KEY_ALG_RSA_2048" {
+			return serviceaccountkey.ServiceAccountKey{}, fmt.Errorf("invalid value for key_algorithm: %q", config.KeyAlgorithm)
+		}
+
+		res, err = c.GCP.CreateKey(ctx, serviceAccountName(serviceAccountID), &iam.CreateServiceAccountKeyRequest{
+			KeyAlgorithm:   algorithm,
+			PrivateKeyType: "TYPE_GOOGLE_CREDENTIALS_FILE",
+		})
+		if err != nil {
+			return serviceaccountkey.ServiceAccountKey{}, err
+		}
+
+		data, err := base64.StdEncoding.DecodeString(res.PrivateKeyData)
+		if err != nil {
+			return serviceaccountkey.ServiceAccountKey{}, fmt.Errorf("invalid private key data in response: %v", err)
+		}
+		credentials = string(data)
+	}
+
+	description, err := json.Marshal(t)
+	if err != nil {
+		return serviceaccountkey.ServiceAccountKey{}, err
+	}
+
+	if _, err := c.GCP.PatchKey(ctx, res.Name, &iam.PatchServiceAccountKeyRequest{
+		ServiceAccountKey: &iam.ServiceAccountKey{
+			Description: string(description),
+		},
+		UpdateMask: "description",
+	}); err != nil {
+		// An untagged key would never be found again, so it's deleted rather than left behind.
+		return serviceaccountkey.ServiceAccountKey{}, errors.Join(err, c.GCP.DeleteKey(ctx, res.Name))
+	}
+	res.Description = string(description)
+
+	return toServiceAccountKey(id, res, credentials)
+}
+
+// findKeys returns the user-managed keys of the service account that are tagged with the name of the key.
+func (c *client) findKeys(ctx context.Context, id identifier.ServiceAccountKeyIdentifier) ([]*iam.ServiceAccountKey, error) {
+	serviceAccountID, ok := id.ServiceAccount.(identifier.ServiceAccountIdentifier)
+	if !ok {
+		return nil, fmt.Errorf("field service_account must be a service_account identifier")
+	}
+
+	res, err := c.GCP.ListKeys(ctx, serviceAccountName(serviceAccountID))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, sdkerrors.NewErrorNotFound()
+		}
+
+		return nil, err
+	}
+
+	var keys []*iam.ServiceAccountKey
+	for _, k := range res {
+		// Keys created some other way aren't managed by this resource.
+		t, ok := parseTag(k.Description)
+		if !ok || t.Name != id.Name {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+func (c *client) deleteKeys(ctx context.Context, keys []*iam.ServiceAccountKey) error {
+	for _, k := range keys {
+		if err := c.GCP.DeleteKey(ctx, k.Name); err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serviceAccountName(id identifier.ServiceAccountIdentifier) string {
+	return fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", id.Project, id.AccountId, id.Project)
+}
+
+// parseTag returns the tag stored in the description of a key, if it has one.
+func parseTag(description string) (tag, bool) {
+	var t tag
+	if err := json.Unmarshal([]byte(description), &t); err != nil || t.Name == "" {
+		return tag{}, false
+	}
+
+	return t, true
+}
+
+func validAfter(key *iam.ServiceAccountKey) time.Time {
+	t, _ := time.Parse(time.RFC3339, key.ValidAfterTime)
+	return t
+}
+
+func isNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+func toServiceAccountKey(id identifier.ServiceAccountKeyIdentifier, key *iam.ServiceAccountKey, privateKey string) (serviceaccountkey.ServiceAccountKey, error) {
+	t, ok := parseTag(key.Description)
+	if !ok {
+		return serviceaccountkey.ServiceAccountKey{}, fmt.Errorf("key %s is not tagged", key.Name)
+	}
+
+	// The algorithm of an uploaded key comes from its certificate, so key_algorithm is left empty.
+	var keyAlgorithm string
+	if key.KeyOrigin != "USER_PROVIDED" {
+		keyAlgorithm = keyAlgorithmField.Format(key.KeyAlgorithm)
+	}
+
+	var publicKeyFile value.File
+	if t.PublicKeyFile != "" {
+		publicKeyFile = value.File{
+			Checksum: t.PublicKeyFile,
+		}
+	}
+
+	// Has the form of projects/<project>/serviceAccounts/<email>/keys/<key id>
+	parts := strings.Split(key.Name, "/")
+
+	return serviceaccountkey.ServiceAccountKey{
+		Identifier: id,
+		Config: serviceaccountkey.Config{
+			KeyAlgorithm:  keyAlgorithm,
+			PublicKeyFile: publicKeyFile,
+			Keepers:       t.Keepers,
+		},
+		Attrs: serviceaccountkey.Attrs{
+			KeyId:       parts[len(parts)-1],
+			ValidAfter:  key.ValidAfterTime,
+			ValidBefore: key.ValidBeforeTime,
+			PrivateKey:  privateKey,
+		},
+	}, nil
+}
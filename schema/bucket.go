@@ -12,7 +12,48 @@ var bucket = schema.ResourceSchema{
 		"name":     schema.String(),
 	}),
 	Config: schema.Struct("config", map[string]schema.FieldSchema{
-		"labels": schema.Map(schema.String()),
+		"labels":     schema.Map(schema.String()),
+		"versioning": schema.Bool(),
+		"lifecycle_rules": schema.List(schema.Struct("lifecycle_rule", map[string]schema.FieldSchema{
+			"action":        schema.String(),
+			"storage_class": schema.String(),
+			"condition": schema.Struct("lifecycle_condition", map[string]schema.FieldSchema{
+				"age":                schema.String(),
+				"created_before":     schema.String(),
+				"num_newer_versions": schema.String(),
+				"matches_prefix":     schema.List(schema.String()),
+				"matches_suffix":     schema.List(schema.String()),
+				"with_state":         schema.String(),
+			}),
+		})),
+		"retention_policy": schema.Struct("retention_policy", map[string]schema.FieldSchema{
+			"retention_period": schema.String(),
+		}),
+		"uniform_bucket_level_access": schema.Bool(),
+		// Either "enforced" or empty, in which case it's inherited from the organization policy.
+		"public_access_prevention": schema.String(),
+		// At most one kms_crypto_key identifier.
+		"default_kms_key": schema.List(schema.Identifier()),
+		// Empty for the default 7 days. "0s" disables soft delete.
+		"soft_delete_retention": schema.String(),
+		// Empty for STANDARD, which is what GCS reports when no storage class is set.
+		"storage_class": schema.String(),
+		"cors": schema.List(schema.Struct("cors", map[string]schema.FieldSchema{
+			"origins":          schema.List(schema.String()),
+			"methods":          schema.List(schema.String()),
+			"response_headers": schema.List(schema.String()),
+			"max_age":          schema.String(),
+		})),
+		"website": schema.Struct("website", map[string]schema.FieldSchema{
+			"main_page_suffix": schema.String(),
+			"not_found_page":   schema.String(),
+		}),
+		"autoclass":      schema.Bool(),
+		"requester_pays": schema.Bool(),
+		// Deletes the bucket's objects along with it. The deleter only receives the identifier, so it's stored
+		// as the athanor-force-destroy label, which can't be set in labels. The deleter only sees the label once
+		// it's applied, so force_destroy has to be applied before the bucket is removed, not in the same run.
+		"force_destroy": schema.Bool(),
 	}),
 	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
 		"create": schema.String(),
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var cloudRunJob = schema.ResourceSchema{
+	Type: "cloud_run_job",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project":  schema.String(),
+		"location": schema.String(),
+		"name":     schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"labels":          schema.Map(schema.String()),
+		"service_account": schema.Identifier(),
+		"task_count":      schema.String(),
+		"parallelism":     schema.String(),
+		"max_retries":     schema.String(),
+		"timeout":         schema.String(),
+		"container": schema.Struct("container", map[string]schema.FieldSchema{
+			"image":   schema.String(),
+			"command": schema.List(schema.String()),
+			"args":    schema.List(schema.String()),
+			"env":     schema.Map(schema.String()),
+			"cpu":     schema.String(),
+			"memory":  schema.String(),
+		}),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"latest_created_execution": schema.String(),
+	}),
+}
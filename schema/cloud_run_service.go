@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var cloudRunService = schema.ResourceSchema{
+	Type: "cloud_run_service",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project":  schema.String(),
+		"location": schema.String(),
+		"name":     schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"description":     schema.String(),
+		"labels":          schema.Map(schema.String()),
+		"ingress":         schema.String(),
+		"service_account": schema.Identifier(),
+		"container": schema.Struct("container", map[string]schema.FieldSchema{
+			"image":  schema.String(),
+			"env":    schema.Map(schema.String()),
+			"cpu":    schema.String(),
+			"memory": schema.String(),
+		}),
+		"scaling": schema.Struct("scaling", map[string]schema.FieldSchema{
+			"min_instance_count": schema.String(),
+			"max_instance_count": schema.String(),
+		}),
+		"max_instance_request_concurrency": schema.String(),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"url":                   schema.String(),
+		"latest_ready_revision": schema.String(),
+	}),
+}
@@ -19,6 +19,40 @@ var function = schema.ResourceSchema{
 			"entrypoint": schema.String(),
 			"source":     schema.File(),
 		}),
+		"service_config": schema.Struct("service_config", map[string]schema.FieldSchema{
+			"available_memory":                 schema.String(),
+			"timeout_seconds":                  schema.String(),
+			"min_instance_count":               schema.String(),
+			"max_instance_count":               schema.String(),
+			"max_instance_request_concurrency": schema.String(),
+			"ingress_settings":                 schema.String(),
+			"vpc_connector":                    schema.String(),
+			"environment_variables":            schema.Map(schema.String()),
+			"service_account":                  schema.Identifier(),
+			"secret_environment_variables": schema.List(schema.Struct("secret_environment_variable", map[string]schema.FieldSchema{
+				"key":     schema.String(),
+				"secret":  schema.Identifier(),
+				"version": schema.String(),
+			})),
+			"secret_volumes": schema.List(schema.Struct("secret_volume", map[string]schema.FieldSchema{
+				"mount_path": schema.String(),
+				"secret":     schema.Identifier(),
+				"version":    schema.String(),
+				"path":       schema.String(),
+			})),
+		}),
+		"event_trigger": schema.List(schema.Struct("event_trigger", map[string]schema.FieldSchema{
+			"event_type":   schema.String(),
+			"pubsub_topic": schema.List(schema.Identifier()),
+			"event_filters": schema.List(schema.Struct("event_filter", map[string]schema.FieldSchema{
+				"attribute": schema.String(),
+				"value":     schema.String(),
+				"operator":  schema.String(),
+			})),
+			"retry_policy":    schema.String(),
+			"trigger_region":  schema.String(),
+			"service_account": schema.Identifier(),
+		})),
 	}),
 	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
 		"url": schema.String(),
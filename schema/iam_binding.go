@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var iamBinding = schema.ResourceSchema{
+	Type: "iam_binding",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"resource": schema.Identifier(),
+		"role":     schema.Identifier(),
+		// The binding is unconditional when the expression is empty.
+		"condition_title":       schema.String(),
+		"condition_description": schema.String(),
+		"condition_expression":  schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"members": schema.List(schema.Identifier()),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"etag": schema.String(),
+	}),
+}
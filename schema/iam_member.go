@@ -0,0 +1,105 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var iamMember = schema.ResourceSchema{
+	Type: "iam_member",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"resource": schema.Identifier(),
+		"role":     schema.Identifier(),
+		"member":   schema.Identifier(),
+		// The binding is unconditional when the expression is empty.
+		"condition_title":       schema.String(),
+		"condition_description": schema.String(),
+		"condition_expression":  schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"etag": schema.String(),
+	}),
+}
+
+// The iam_member_* types only exist to identify principals in IAM bindings. They have no handlers.
+
+var iamMemberUser = schema.ResourceSchema{
+	Type: "iam_member_user",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"email": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+var iamMemberGroup = schema.ResourceSchema{
+	Type: "iam_member_group",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"email": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+var iamMemberDomain = schema.ResourceSchema{
+	Type: "iam_member_domain",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"domain": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+// Either "allUsers" or "allAuthenticatedUsers".
+var iamMemberPublic = schema.ResourceSchema{
+	Type: "iam_member_public",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"principal": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+// Google-managed service accounts, such as the Compute Engine default service account or a service agent.
+var iamMemberGoogleServiceAccount = schema.ResourceSchema{
+	Type: "iam_member_google_service_account",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"email": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+// Convenience values for the owners, editors or viewers of a project. Buckets have them in their default policy.
+var iamMemberProjectRole = schema.ResourceSchema{
+	Type: "iam_member_project_role",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project": schema.String(),
+		"role":    schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+// A principal or set of principals in a workforce or workload identity pool, given by its full
+// principal:// or principalSet:// URI.
+var iamMemberPrincipal = schema.ResourceSchema{
+	Type: "iam_member_principal",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"uri": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
+
+// A user, group or service account that was deleted while it was still bound to a role. member is the
+// principal it had before, such as "user:alice@example.com".
+var iamMemberDeleted = schema.ResourceSchema{
+	Type: "iam_member_deleted",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"member": schema.String(),
+		"uid":    schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
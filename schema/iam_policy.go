@@ -7,12 +7,19 @@ import (
 var iamPolicy = schema.ResourceSchema{
 	Type: "iam_policy",
 	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		// Deleting a project's policy keeps its roles/owner bindings, so that the project isn't left without owners.
 		"resource": schema.Identifier(),
 	}),
 	Config: schema.Struct("config", map[string]schema.FieldSchema{
 		"bindings": schema.List(schema.Struct("binding", map[string]schema.FieldSchema{
 			"role":    schema.Identifier(),
 			"members": schema.List(schema.Identifier()),
+			// The binding is unconditional when the expression is empty.
+			"condition": schema.Struct("condition", map[string]schema.FieldSchema{
+				"title":       schema.String(),
+				"description": schema.String(),
+				"expression":  schema.String(),
+			}),
 		})),
 	}),
 	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var iamRoleQuery = schema.ResourceSchema{
+	Type: "iam_role_query",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"resource":    schema.Identifier(),
+		"permissions": schema.List(schema.String()),
+		"title":       schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"roles": schema.List(schema.Identifier()),
+	}),
+}
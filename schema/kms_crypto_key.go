@@ -0,0 +1,18 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+// kms_crypto_key only exists to reference existing keys, such as a bucket's default key. It has no handler.
+var kmsCryptoKey = schema.ResourceSchema{
+	Type: "kms_crypto_key",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project":  schema.String(),
+		"location": schema.String(),
+		"key_ring": schema.String(),
+		"name":     schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs:  schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
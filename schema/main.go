@@ -16,11 +16,32 @@ func main() {
 			apiGateway,
 			bucket,
 			bucketObject,
+			cloudRunJob,
+			cloudRunService,
 			function,
+			iamBinding,
+			iamMember,
+			iamMemberDeleted,
+			iamMemberDomain,
+			iamMemberGoogleServiceAccount,
+			iamMemberGroup,
+			iamMemberPrincipal,
+			iamMemberProjectRole,
+			iamMemberPublic,
+			iamMemberUser,
 			iamPolicy,
 			iamRole,
+			iamRoleCustomOrganization,
 			iamRoleCustomProject,
+			iamRoleQuery,
+			kmsCryptoKey,
+			project,
+			pubsubSubscription,
+			pubsubTopic,
+			secret,
+			secretVersion,
 			serviceAccount,
+			serviceAccountKey,
 		},
 	}
 
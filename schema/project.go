@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var project = schema.ResourceSchema{
+	Type: "project",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project_id": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"project_number": schema.String(),
+		"display_name":   schema.String(),
+		"parent":         schema.String(),
+		"state":          schema.String(),
+		"etag":           schema.String(),
+	}),
+}
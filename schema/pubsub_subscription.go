@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var pubsubSubscription = schema.ResourceSchema{
+	Type: "pubsub_subscription",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"topic": schema.Identifier(),
+		"name":  schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"labels":               schema.Map(schema.String()),
+		"ack_deadline_seconds": schema.String(),
+		"push_endpoint":        schema.String(),
+		"dead_letter_policy": schema.List(schema.Struct("dead_letter_policy", map[string]schema.FieldSchema{
+			"dead_letter_topic":     schema.Identifier(),
+			"max_delivery_attempts": schema.String(),
+		})),
+		"retry_policy": schema.Struct("retry_policy", map[string]schema.FieldSchema{
+			"minimum_backoff": schema.String(),
+			"maximum_backoff": schema.String(),
+		}),
+		"filter":                       schema.Immutable(schema.String()),
+		"enable_exactly_once_delivery": schema.Bool(),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
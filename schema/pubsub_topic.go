@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var pubsubTopic = schema.ResourceSchema{
+	Type: "pubsub_topic",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project": schema.String(),
+		"name":    schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"labels":                     schema.Map(schema.String()),
+		"message_retention_duration": schema.String(),
+		"kms_key_name":               schema.String(),
+		"schema_settings": schema.Struct("schema_settings", map[string]schema.FieldSchema{
+			"schema":   schema.String(),
+			"encoding": schema.String(),
+		}),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{}),
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var secret = schema.ResourceSchema{
+	Type: "secret",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"project": schema.String(),
+		"name":    schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"labels":                schema.Map(schema.String()),
+		"annotations":           schema.Map(schema.String()),
+		"replication_locations": schema.Immutable(schema.List(schema.String())),
+		"topics":                schema.List(schema.Identifier()),
+		"rotation_period":       schema.String(),
+		"ttl":                   schema.Immutable(schema.String()),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"create":             schema.String(),
+		"expire_time":        schema.String(),
+		"next_rotation_time": schema.String(),
+		"etag":               schema.String(),
+	}),
+}
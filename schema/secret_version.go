@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var secretVersion = schema.ResourceSchema{
+	Type: "secret_version",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"secret": schema.Identifier(),
+		// Names the version within the secret. GCP numbers versions itself, so the number it assigns is
+		// tracked in an annotation on the secret under this name.
+		"name": schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		"contents": schema.File(),
+		// Destroys the version instead of disabling it on delete.
+		"destroy_on_delete": schema.Bool(),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"version": schema.String(),
+		"state":   schema.String(),
+		"create":  schema.String(),
+	}),
+}
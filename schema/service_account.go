@@ -13,9 +13,9 @@ var serviceAccount = schema.ResourceSchema{
 	Config: schema.Struct("config", map[string]schema.FieldSchema{
 		"display_name": schema.String(),
 		"description":  schema.String(),
+		"disabled":     schema.Bool(),
 	}),
 	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
 		"unique_id": schema.String(),
-		"disabled":  schema.Bool(),
 	}),
 }
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/alchematik/athanor-go/sdk/provider/schema"
+)
+
+var serviceAccountKey = schema.ResourceSchema{
+	Type: "service_account_key",
+	Identifier: schema.Struct("identifier", map[string]schema.FieldSchema{
+		"service_account": schema.Identifier(),
+		"name":            schema.String(),
+	}),
+	Config: schema.Struct("config", map[string]schema.FieldSchema{
+		// Either "KEY_ALG_RSA_1024" or empty for RSA 2048. It can't be set along with public_key_file.
+		"key_algorithm": schema.String(),
+		// A PEM encoded X.509 certificate to upload instead of having GCP create the key. Uploaded keys have no
+		// private_key.
+		"public_key_file": schema.File(),
+		"keepers":         schema.Map(schema.String()),
+	}),
+	Attrs: schema.Struct("attrs", map[string]schema.FieldSchema{
+		"key_id":       schema.String(),
+		"valid_after":  schema.String(),
+		"valid_before": schema.String(),
+		// Credentials JSON for the key. It's only set when the key is created, since GCP doesn't keep the private key.
+		// The schema has no way to mark a field as sensitive, so it should be treated as such by whatever consumes it.
+		"private_key": schema.String(),
+	}),
+}